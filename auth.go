@@ -0,0 +1,73 @@
+package razorpay
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// Authenticator authenticates requests made by Client e.g. by setting
+// 'Authorization' header in params.
+type Authenticator interface {
+	Authenticate(ctx context.Context, params RequestParams) error
+}
+
+// Refresher is implemented by authenticators whose credential can be
+// refreshed. Client invokes Refresh with params of request and retries once
+// when remote responds with 401, unless request body can not be sent again,
+// see BodyRewinder. Params carry credential request was sent with, so that
+// refresh can be skipped if credential has changed since.
+type Refresher interface {
+	Refresh(ctx context.Context, params RequestParams) error
+}
+
+// BasicAuthenticator implements Authenticator using http basic auth of api
+// key and secret, consulting credentials provider on each request.
+type BasicAuthenticator struct {
+	Provider CredentialsProvider
+}
+
+// Authenticate sets 'Authorization' header in params.
+func (a *BasicAuthenticator) Authenticate(ctx context.Context, params RequestParams) error {
+	credentials, err := a.Provider.Credentials(ctx)
+	if err != nil {
+		return err
+	}
+	params.SetHeader("Authorization", basicAuthorization(credentials))
+	return nil
+}
+
+// Refresh refreshes credentials of provider if it implements Refresher e.g.
+// CachingCredentialsProvider, so that rotated secret is picked before expiry
+// of cache. Other providers are consulted on each request anyway.
+func (a *BasicAuthenticator) Refresh(ctx context.Context, params RequestParams) error {
+	if refresher, ok := a.Provider.(Refresher); ok {
+		return refresher.Refresh(ctx, params)
+	}
+	return errNotRefreshable
+}
+
+// errNotRefreshable is returned by Refresh when credential can not be
+// refreshed, in which case request is not retried.
+var errNotRefreshable = errors.New("credential can not be refreshed")
+
+// basicAuthorization returns value of 'Authorization' header for credentials.
+func basicAuthorization(credentials *Credentials) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials.APIKey+":"+credentials.APISecret))
+}
+
+// BearerAuthenticator implements Authenticator using bearer token e.g. an
+// OAuth access token. Refer oauth package for token exchange and refresh.
+type BearerAuthenticator struct {
+	Token string
+}
+
+// Authenticate sets 'Authorization' header in params.
+func (a *BearerAuthenticator) Authenticate(_ context.Context, params RequestParams) error {
+	if a.Token == "" {
+		return fmt.Errorf("bearer token is empty")
+	}
+	params.SetHeader("Authorization", "Bearer "+a.Token)
+	return nil
+}
@@ -0,0 +1,69 @@
+package razorpay
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// DefaultBackoff is backoff used by polling helpers when none is given.
+var DefaultBackoff = &Backoff{Initial: time.Second, Max: 30 * time.Second, Multiplier: 2, Jitter: 0.2}
+
+// Backoff is exponential backoff between attempts e.g. when polling status
+// of payment or refund.
+type Backoff struct {
+	// Initial is wait after first attempt.
+	Initial time.Duration
+	// Max is maximum wait between attempts.
+	Max time.Duration
+	// Multiplier is factor by which wait grows after each attempt.
+	Multiplier float64
+	// Jitter is fraction, between 0 and 1, by which wait is randomized so that
+	// many pollers do not hit remote at once.
+	Jitter float64
+}
+
+// Duration returns wait after given attempt, starting from 0.
+func (b *Backoff) Duration(attempt int) time.Duration {
+	d := float64(b.Initial) * math.Pow(b.Multiplier, float64(attempt))
+	if b.Max > 0 && d > float64(b.Max) {
+		d = float64(b.Max)
+	}
+	if b.Jitter > 0 {
+		d += d * b.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(d)
+}
+
+// Wait waits for duration of given attempt. It returns early with error of
+// context if it is done.
+func (b *Backoff) Wait(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(b.Duration(attempt))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Poll calls fn until it returns done or permanent error, waiting between
+// calls as per backoff. Temporary errors e.g. 429, 5xx and timeouts are
+// retried, see IsTemporaryError. It returns error of context if it is done
+// before. DefaultBackoff is used if b is nil.
+func Poll(ctx context.Context, b *Backoff, fn func(ctx context.Context) (done bool, err error)) error {
+	if b == nil {
+		b = DefaultBackoff
+	}
+	for attempt := 0; ; attempt++ {
+		done, err := fn(ctx)
+		if (err != nil && !IsTemporaryError(err)) || (err == nil && done) {
+			return err
+		}
+		if err := b.Wait(ctx, attempt); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package razorpay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff_Duration(t *testing.T) {
+	b := &Backoff{Initial: time.Second, Max: 5 * time.Second, Multiplier: 2}
+	assert.Equal(t, time.Second, b.Duration(0))
+	assert.Equal(t, 2*time.Second, b.Duration(1))
+	assert.Equal(t, 4*time.Second, b.Duration(2))
+	assert.Equal(t, 5*time.Second, b.Duration(3))
+
+	// Case: Jitter keeps wait within fraction.
+	b.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := b.Duration(1)
+		assert.True(t, d >= time.Second && d <= 3*time.Second)
+	}
+}
+
+func TestPoll(t *testing.T) {
+	b := &Backoff{Initial: time.Millisecond, Multiplier: 1}
+
+	// Case: Done after few attempts.
+	calls := 0
+	err := Poll(context.Background(), b, func(ctx context.Context) (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+
+	// Case: Temporary errors are retried.
+	calls = 0
+	err = Poll(context.Background(), b, func(ctx context.Context) (bool, error) {
+		calls++
+		switch calls {
+		case 1:
+			return false, &Error{StatusCode: http.StatusTooManyRequests}
+		case 2:
+			return false, &net.OpError{Op: "read", Err: syscall.ECONNRESET}
+		}
+		return true, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+
+	// Case: Permanent error is returned as is.
+	errFailed := &Error{StatusCode: http.StatusBadRequest, Code: "BAD_REQUEST_ERROR"}
+	err = Poll(context.Background(), b, func(ctx context.Context) (bool, error) {
+		return false, errFailed
+	})
+	assert.Equal(t, errFailed, err)
+
+	// Case: Decode and credentials provider errors are not retried.
+	for _, errPermanent := range []error{
+		&json.SyntaxError{},
+		fmt.Errorf("cannot read credentials: %w", errors.New("secret not found")),
+	} {
+		calls = 0
+		err = Poll(context.Background(), b, func(ctx context.Context) (bool, error) {
+			calls++
+			return false, errPermanent
+		})
+		assert.Equal(t, errPermanent, err)
+		assert.Equal(t, 1, calls)
+	}
+
+	// Case: Context is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = Poll(ctx, b, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
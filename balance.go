@@ -0,0 +1,8 @@
+package razorpay
+
+// Balance is balance of account, in minor unit.
+type Balance struct {
+	Response
+	Balance  int64    `json:"balance"`
+	Currency Currency `json:"currency"`
+}
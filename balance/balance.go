@@ -0,0 +1,34 @@
+package balance
+
+import (
+	"context"
+	"net/http"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+)
+
+// Client is used to access /balance apis.
+type Client struct {
+	*razorpay.Client
+}
+
+// Get returns balance of account.
+func (c *Client) Get(ctx context.Context) (*razorpay.Balance, error) {
+	balance := &razorpay.Balance{}
+	err := c.Call(ctx, http.MethodGet, "/balance", nil, balance)
+	return balance, err
+}
+
+// Get returns balance of account.
+func Get(ctx context.Context) (*razorpay.Balance, error) {
+	return getDefaultClient().Get(ctx)
+}
+
+// NewClient returns new client.
+func NewClient(apiKey string, apiSecret string, apiBackend razorpay.Backend) *Client {
+	return &Client{razorpay.NewClient(apiKey, apiSecret, apiBackend)}
+}
+
+func getDefaultClient() *Client {
+	return &Client{razorpay.GetDefaultClient()}
+}
@@ -0,0 +1,16 @@
+package balance
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/jitendra-1217/razorpay-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Get(t *testing.T) {
+	balance, err := Get(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, balance.Balance >= 0)
+	assert.True(t, balance.Currency.IsValid())
+}
@@ -0,0 +1,149 @@
+package razorpay
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"os"
+	"strings"
+)
+
+// RequestBody is encoded request body.
+type RequestBody struct {
+	Reader      io.Reader
+	ContentType string
+
+	// ContentLength is length of body in bytes, or -1 if unknown in which case
+	// body is sent chunked.
+	ContentLength int64
+}
+
+// BodyEncoder is implemented by params which encode own request body instead
+// of json, for example multipart.
+type BodyEncoder interface {
+	EncodeBody() (*RequestBody, error)
+}
+
+// EncodeJSONBody encodes v as json body. It is the default for params not
+// implementing BodyEncoder.
+func EncodeJSONBody(v interface{}) (*RequestBody, error) {
+	jsonBody, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return &RequestBody{bytes.NewReader(jsonBody), "application/json", int64(len(jsonBody))}, nil
+}
+
+// BodyRewinder is implemented by params with own body, whose body can be sent
+// again e.g. as file reader can be seeked back. Client retries request with
+// own body only if it is rewound.
+type BodyRewinder interface {
+	RewindBody() error
+}
+
+// MultipartField is a non-file field of multipart body.
+type MultipartField struct {
+	Name  string
+	Value string
+}
+
+// MultipartFile is a file field of multipart body. File is streamed from
+// reader and not buffered in memory, and so request with it can be retried
+// only if params rewind the reader, see BodyRewinder.
+type MultipartFile struct {
+	FieldName   string
+	FileName    string
+	ContentType string
+	Reader      io.Reader
+
+	// Size is length of file in bytes. When zero, it is detected from reader
+	// if possible e.g. for *os.File, *bytes.Reader, *strings.Reader. If size
+	// of any file is unknown, body is sent chunked.
+	Size int64
+}
+
+// EncodeMultipartBody encodes fields and files as multipart form body. Files
+// are streamed from their readers, and content length is computed if sizes
+// of all files are known.
+func EncodeMultipartBody(fields []*MultipartField, files []*MultipartFile) (*RequestBody, error) {
+	// Renders everything except file contents into buffer, and splits it into
+	// segments around each file so that file readers can be interleaved.
+	buf := &bytes.Buffer{}
+	w := multipart.NewWriter(buf)
+	for _, field := range fields {
+		if err := w.WriteField(field.Name, field.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	readers := []io.Reader{}
+	contentLength := int64(0)
+	for _, file := range files {
+		if file.Reader == nil {
+			return nil, fmt.Errorf("reader of file %q is missing", file.FieldName)
+		}
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, escapeQuotes(file.FieldName), escapeQuotes(file.FileName)))
+		header.Set("Content-Type", contentType)
+		if _, err := w.CreatePart(header); err != nil {
+			return nil, err
+		}
+
+		segment := append([]byte(nil), buf.Bytes()...)
+		buf.Reset()
+		readers = append(readers, bytes.NewReader(segment), file.Reader)
+
+		size := file.Size
+		if size == 0 {
+			size = readerSize(file.Reader)
+		}
+		if size < 0 || contentLength < 0 {
+			contentLength = -1
+		} else {
+			contentLength += int64(len(segment)) + size
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	readers = append(readers, bytes.NewReader(buf.Bytes()))
+	if contentLength >= 0 {
+		contentLength += int64(buf.Len())
+	}
+
+	return &RequestBody{io.MultiReader(readers...), w.FormDataContentType(), contentLength}, nil
+}
+
+// readerSize returns remaining length of reader, or -1 if unknown.
+func readerSize(r io.Reader) int64 {
+	switch v := r.(type) {
+	case interface{ Len() int }:
+		return int64(v.Len())
+	case *os.File:
+		info, err := v.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return -1
+		}
+		offset, err := v.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return -1
+		}
+		return info.Size() - offset
+	}
+	return -1
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// escapeQuotes escapes quotes in form field and file names, same as done in
+// mime/multipart.
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
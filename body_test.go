@@ -0,0 +1,128 @@
+package razorpay
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeMultipartBody(t *testing.T) {
+	// Case: Content length is known for sized readers.
+	body, err := EncodeMultipartBody(
+		[]*MultipartField{{"purpose", "dispute_evidence"}},
+		[]*MultipartFile{{FieldName: "file", FileName: "invoice.pdf", ContentType: "application/pdf", Reader: strings.NewReader("%PDF-1.4")}},
+	)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(body.ContentType, "multipart/form-data; boundary="))
+	content, err := ioutil.ReadAll(body.Reader)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(content)), body.ContentLength)
+
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(content))
+	req.Header.Set("Content-Type", body.ContentType)
+	assert.Nil(t, req.ParseMultipartForm(1<<20))
+	assert.Equal(t, "dispute_evidence", req.FormValue("purpose"))
+	file, header, err := req.FormFile("file")
+	assert.Nil(t, err)
+	assert.Equal(t, "invoice.pdf", header.Filename)
+	assert.Equal(t, "application/pdf", header.Header.Get("Content-Type"))
+	fileContent, _ := ioutil.ReadAll(file)
+	assert.Equal(t, "%PDF-1.4", string(fileContent))
+
+	// Case: Content length is unknown for unsized readers.
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write([]byte("streamed"))
+		pw.Close()
+	}()
+	body, err = EncodeMultipartBody(nil, []*MultipartFile{{FieldName: "file", FileName: "a.txt", Reader: pr}})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-1), body.ContentLength)
+	content, err = ioutil.ReadAll(body.Reader)
+	assert.Nil(t, err)
+	assert.Contains(t, string(content), "streamed")
+
+	// Case: Missing reader.
+	_, err = EncodeMultipartBody(nil, []*MultipartFile{{FieldName: "file"}})
+	assert.NotNil(t, err)
+}
+
+func TestAPIBackend_Call_DocumentParams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/documents", r.URL.Path)
+		assert.True(t, r.ContentLength > 0)
+		assert.Nil(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "dispute_evidence", r.FormValue("purpose"))
+		w.Write([]byte(`{"id":"doc_1","purpose":"dispute_evidence"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("key", "secret", &APIBackend{server.URL, server.Client()})
+	params := &DocumentParams{Purpose: String("dispute_evidence"), FileName: "a.txt", File: strings.NewReader("content")}
+	document := &Document{}
+	err := client.Call(context.Background(), http.MethodPost, "/documents", params, document)
+	assert.Nil(t, err)
+	assert.Equal(t, "doc_1", document.ID)
+}
+
+// refreshingAuthenticator authenticates with token, which is stale until
+// refreshed.
+type refreshingAuthenticator struct {
+	token string
+}
+
+func (a *refreshingAuthenticator) Authenticate(_ context.Context, params RequestParams) error {
+	params.SetHeader("Authorization", "Bearer "+a.token)
+	return nil
+}
+
+func (a *refreshingAuthenticator) Refresh(_ context.Context, _ RequestParams) error {
+	a.token = "fresh"
+	return nil
+}
+
+func TestClient_Call_DocumentParamsRefresh(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Nil(t, r.ParseMultipartForm(1<<20))
+		file, _, err := r.FormFile("file")
+		assert.Nil(t, err)
+		content, _ := ioutil.ReadAll(file)
+		assert.Equal(t, "content", string(content))
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":{"code":"BAD_REQUEST_ERROR","description":"token expired"}}`))
+			return
+		}
+		w.Write([]byte(`{"id":"doc_1"}`))
+	}))
+	defer server.Close()
+
+	// Case: Seekable file is sent again in full, after refresh.
+	client := NewClientWithAuthenticator(&refreshingAuthenticator{"stale"}, &APIBackend{server.URL, server.Client()})
+	params := &DocumentParams{Purpose: String("dispute_evidence"), FileName: "a.txt", File: strings.NewReader("content")}
+	document := &Document{}
+	err := client.Call(context.Background(), http.MethodPost, "/documents", params, document)
+	assert.Nil(t, err)
+	assert.Equal(t, "doc_1", document.ID)
+	assert.Equal(t, 2, requests)
+
+	// Case: Unseekable file is not sent again, and 401 is returned.
+	requests = 0
+	client = NewClientWithAuthenticator(&refreshingAuthenticator{"stale"}, &APIBackend{server.URL, server.Client()})
+	params = &DocumentParams{Purpose: String("dispute_evidence"), FileName: "a.txt", File: io.MultiReader(strings.NewReader("content"))}
+	err = client.Call(context.Background(), http.MethodPost, "/documents", params, &Document{})
+	var razorpayErr *Error
+	assert.True(t, errors.As(err, &razorpayErr))
+	assert.Equal(t, http.StatusUnauthorized, razorpayErr.StatusCode)
+	assert.Equal(t, 1, requests)
+}
@@ -0,0 +1,76 @@
+package razorpay
+
+// Card is a Razorpay entity representation.
+type Card struct {
+	Response
+	Entity
+	Name          string   `json:"name"`
+	Last4         string   `json:"last4"`
+	Network       string   `json:"network"`
+	Type          CardType `json:"type"`
+	SubType       string   `json:"sub_type"`
+	Issuer        string   `json:"issuer"`
+	International bool     `json:"international"`
+	Emi           bool     `json:"emi"`
+	TokenIIN      string   `json:"token_iin"`
+}
+
+// CardType is type of card. Unknown values are retained as is when
+// unmarshalling, and so IsValid can be used to detect them.
+type CardType string
+
+// List of card types.
+const (
+	CardTypeCredit  CardType = "credit"
+	CardTypeDebit   CardType = "debit"
+	CardTypePrepaid CardType = "prepaid"
+	CardTypeUnknown CardType = "unknown"
+)
+
+// IsValid returns if type is one of known types.
+func (t CardType) IsValid() bool {
+	switch t {
+	case CardTypeCredit, CardTypeDebit, CardTypePrepaid, CardTypeUnknown:
+		return true
+	}
+	return false
+}
+
+// IIN is issuer identification number i.e. first digits of card, and details
+// of cards issued under it.
+type IIN struct {
+	Response
+	IIN                 string                   `json:"iin"`
+	Network             string                   `json:"network"`
+	Type                CardType                 `json:"type"`
+	SubType             string                   `json:"sub_type"`
+	IssuerCode          string                   `json:"issuer_code"`
+	IssuerName          string                   `json:"issuer_name"`
+	International       bool                     `json:"international"`
+	IsTokenized         bool                     `json:"is_tokenized"`
+	CardIIN             string                   `json:"card_iin"`
+	Emi                 IINFeature               `json:"emi"`
+	Recurring           IINFeature               `json:"recurring"`
+	AuthenticationTypes []*IINAuthenticationType `json:"authentication_types"`
+}
+
+// IINFeature is availability of feature for cards of IIN.
+type IINFeature struct {
+	Available bool `json:"available"`
+}
+
+// IINAuthenticationType is authentication supported for cards of IIN e.g.
+// 3ds or otp.
+type IINAuthenticationType struct {
+	Type string `json:"type"`
+}
+
+// SupportsAuthentication returns if cards of IIN support authentication type.
+func (i *IIN) SupportsAuthentication(authType string) bool {
+	for _, t := range i.AuthenticationTypes {
+		if t.Type == authType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package card
+
+import (
+	"context"
+	"net/http"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+)
+
+// Client is used to access /cards and /iins apis.
+type Client struct {
+	*razorpay.Client
+}
+
+// Get returns card for id.
+func (c *Client) Get(ctx context.Context, id string) (*razorpay.Card, error) {
+	card := &razorpay.Card{}
+	err := c.Call(ctx, http.MethodGet, "/cards/"+id, nil, card)
+	return card, err
+}
+
+// GetIIN returns details of IIN i.e. first 6 or more digits of card.
+func (c *Client) GetIIN(ctx context.Context, bin string) (*razorpay.IIN, error) {
+	iin := &razorpay.IIN{}
+	err := c.Call(ctx, http.MethodGet, "/iins/"+bin, nil, iin)
+	return iin, err
+}
+
+// Get returns card for id.
+func Get(ctx context.Context, id string) (*razorpay.Card, error) {
+	return getDefaultClient().Get(ctx, id)
+}
+
+// GetIIN returns details of IIN i.e. first 6 or more digits of card.
+func GetIIN(ctx context.Context, bin string) (*razorpay.IIN, error) {
+	return getDefaultClient().GetIIN(ctx, bin)
+}
+
+// NewClient returns new client.
+func NewClient(apiKey string, apiSecret string, apiBackend razorpay.Backend) *Client {
+	return &Client{razorpay.NewClient(apiKey, apiSecret, apiBackend)}
+}
+
+func getDefaultClient() *Client {
+	return &Client{razorpay.GetDefaultClient()}
+}
@@ -0,0 +1,34 @@
+package card
+
+import (
+	"context"
+	"testing"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+	"github.com/jitendra-1217/razorpay-go/payment"
+	_ "github.com/jitendra-1217/razorpay-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	// paymentID holds an existing captured card payment id.
+	paymentID = "pay_FtZSrSlgxsJKiQ"
+)
+
+func TestClient_Get(t *testing.T) {
+	paymentCard, err := payment.GetCard(context.Background(), paymentID)
+	assert.Nil(t, err)
+	card, err := Get(context.Background(), paymentCard.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, paymentCard.ID, card.ID)
+	assert.Equal(t, paymentCard.Last4, card.Last4)
+	assert.True(t, card.Type.IsValid())
+}
+
+func TestClient_GetIIN(t *testing.T) {
+	iin, err := GetIIN(context.Background(), "411111")
+	assert.Nil(t, err)
+	assert.Equal(t, "411111", iin.IIN)
+	assert.Equal(t, "Visa", iin.Network)
+	assert.Equal(t, razorpay.CardTypeCredit, iin.Type)
+}
@@ -0,0 +1,73 @@
+// Command capture-sweeper captures payments which remain authorized in a
+// window, e.g. when capture call after payment failed. Credentials are read
+// from RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET env values. Result of each
+// payment is written to stdout as json line, and summary to stderr.
+//
+// Usage:
+//
+//	capture-sweeper -window 72h -require-order -dry-run
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+	"github.com/jitendra-1217/razorpay-go/payment"
+)
+
+func main() {
+	window := flag.Duration("window", 72*time.Hour, "sweeps payments created within window till now")
+	skip := flag.Duration("skip-recent", 15*time.Minute, "skips payments created recently, which may still be captured by regular flow")
+	requireOrder := flag.Bool("require-order", false, "skips payments not made against an order")
+	dryRun := flag.Bool("dry-run", false, "reports payments which would be captured, without capturing")
+	flag.Parse()
+	if *window <= *skip {
+		fmt.Fprintln(os.Stderr, "window must be longer than skip-recent")
+		os.Exit(2)
+	}
+
+	// Stops sweeping on interrupt, reporting results so far.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		<-interrupt
+		cancel()
+	}()
+
+	client := &payment.Client{Client: razorpay.NewClientWithCredentialsProvider(&razorpay.EnvCredentialsProvider{}, nil)}
+	now := time.Now()
+	params := &payment.SweepParams{
+		From:         now.Add(-*window),
+		To:           now.Add(-*skip),
+		RequireOrder: *requireOrder,
+		DryRun:       *dryRun,
+	}
+	report, err := client.SweepAuthorized(ctx, params)
+	if report != nil {
+		encoder := json.NewEncoder(os.Stdout)
+		for _, result := range report.Results {
+			encoder.Encode(result) //nolint
+		}
+		fmt.Fprintf(os.Stderr, "captured: %d, already captured: %d, capturable: %d, skipped: %d, failed: %d\n",
+			report.Count(payment.SweepStatusCaptured),
+			report.Count(payment.SweepStatusAlreadyCaptured),
+			report.Count(payment.SweepStatusCapturable),
+			report.Count(payment.SweepStatusSkipped),
+			report.Count(payment.SweepStatusFailed))
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if report.Count(payment.SweepStatusFailed) > 0 {
+		os.Exit(1)
+	}
+}
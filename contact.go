@@ -0,0 +1,79 @@
+package razorpay
+
+// Contact is a RazorpayX entity representation of payee e.g. vendor or
+// employee, whom payouts are made to.
+type Contact struct {
+	Response
+	Entity
+	Name        string      `json:"name"`
+	Contact     string      `json:"contact"`
+	Email       string      `json:"email"`
+	Type        ContactType `json:"type"`
+	ReferenceID string      `json:"reference_id"`
+	BatchID     string      `json:"batch_id"`
+	Active      bool        `json:"active"`
+	Notes       Notes       `json:"notes"`
+}
+
+// ContactType is type of contact. Unknown values, including custom types
+// created on dashboard, are retained as is when unmarshalling.
+type ContactType string
+
+// List of contact types.
+const (
+	ContactTypeVendor   ContactType = "vendor"
+	ContactTypeCustomer ContactType = "customer"
+	ContactTypeEmployee ContactType = "employee"
+	ContactTypeSelf     ContactType = "self"
+)
+
+// IsValid returns if type is one of known types.
+func (t ContactType) IsValid() bool {
+	switch t {
+	case ContactTypeVendor, ContactTypeCustomer, ContactTypeEmployee, ContactTypeSelf:
+		return true
+	}
+	return false
+}
+
+// Ptr returns pointer to type value.
+func (t ContactType) Ptr() *ContactType {
+	return &t
+}
+
+// ContactList is collection of contacts.
+type ContactList struct {
+	Response
+	EntityList
+	Contacts []*Contact `json:"items"`
+}
+
+// ContactParams is list of params that can be used when creating or updating
+// contact.
+type ContactParams struct {
+	Params
+	Name        *string      `json:"name,omitempty"`
+	Email       *string      `json:"email,omitempty"`
+	Contact     *string      `json:"contact,omitempty"`
+	Type        *ContactType `json:"type,omitempty"`
+	ReferenceID *string      `json:"reference_id,omitempty"`
+	Notes       Notes        `json:"notes,omitempty"`
+}
+
+// ContactActiveParams is list of params that can be used when activating or
+// deactivating contact.
+type ContactActiveParams struct {
+	Params
+	Active *bool `json:"active,omitempty"`
+}
+
+// ContactListParams is list params that can be used when listing contacts.
+type ContactListParams struct {
+	ListParams
+	Name        *string      `url:"name,omitempty"`
+	Email       *string      `url:"email,omitempty"`
+	Contact     *string      `url:"contact,omitempty"`
+	ReferenceID *string      `url:"reference_id,omitempty"`
+	Type        *ContactType `url:"type,omitempty"`
+	Active      *bool        `url:"active,omitempty"`
+}
@@ -0,0 +1,107 @@
+package contact
+
+import (
+	"context"
+	"net/http"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+)
+
+// Client is used to access /contacts apis.
+type Client struct {
+	*razorpay.Client
+}
+
+// Create creates new contact.
+func (c *Client) Create(ctx context.Context, params *razorpay.ContactParams) (*razorpay.Contact, error) {
+	contact := &razorpay.Contact{}
+	if err := c.ValidateParams(params); err != nil {
+		return contact, err
+	}
+	err := c.Call(ctx, http.MethodPost, "/contacts", params, contact)
+	return contact, err
+}
+
+// Update updates existing contact.
+func (c *Client) Update(ctx context.Context, id string, params *razorpay.ContactParams) (*razorpay.Contact, error) {
+	contact := &razorpay.Contact{}
+	if err := c.ValidateParams(params); err != nil {
+		return contact, err
+	}
+	err := c.Call(ctx, http.MethodPatch, "/contacts/"+id, params, contact)
+	return contact, err
+}
+
+// Get returns contact for id.
+func (c *Client) Get(ctx context.Context, id string) (*razorpay.Contact, error) {
+	contact := &razorpay.Contact{}
+	err := c.Call(ctx, http.MethodGet, "/contacts/"+id, nil, contact)
+	return contact, err
+}
+
+// List returns list of contacts for params.
+func (c *Client) List(ctx context.Context, params *razorpay.ContactListParams) (*razorpay.ContactList, error) {
+	if params == nil {
+		params = &razorpay.ContactListParams{}
+	}
+
+	contactList := &razorpay.ContactList{}
+	err := c.Call(ctx, http.MethodGet, "/contacts", params, contactList)
+	return contactList, err
+}
+
+// Activate activates contact.
+func (c *Client) Activate(ctx context.Context, id string) (*razorpay.Contact, error) {
+	return c.setActive(ctx, id, true)
+}
+
+// Deactivate deactivates contact, after which payouts can not be made to it.
+func (c *Client) Deactivate(ctx context.Context, id string) (*razorpay.Contact, error) {
+	return c.setActive(ctx, id, false)
+}
+
+func (c *Client) setActive(ctx context.Context, id string, active bool) (*razorpay.Contact, error) {
+	params := &razorpay.ContactActiveParams{Active: razorpay.Bool(active)}
+	contact := &razorpay.Contact{}
+	err := c.Call(ctx, http.MethodPatch, "/contacts/"+id, params, contact)
+	return contact, err
+}
+
+// Create creates new contact.
+func Create(ctx context.Context, params *razorpay.ContactParams) (*razorpay.Contact, error) {
+	return getDefaultClient().Create(ctx, params)
+}
+
+// Update updates existing contact.
+func Update(ctx context.Context, id string, params *razorpay.ContactParams) (*razorpay.Contact, error) {
+	return getDefaultClient().Update(ctx, id, params)
+}
+
+// Get returns contact for id.
+func Get(ctx context.Context, id string) (*razorpay.Contact, error) {
+	return getDefaultClient().Get(ctx, id)
+}
+
+// List returns list of contacts for params.
+func List(ctx context.Context, params *razorpay.ContactListParams) (*razorpay.ContactList, error) {
+	return getDefaultClient().List(ctx, params)
+}
+
+// Activate activates contact.
+func Activate(ctx context.Context, id string) (*razorpay.Contact, error) {
+	return getDefaultClient().Activate(ctx, id)
+}
+
+// Deactivate deactivates contact, after which payouts can not be made to it.
+func Deactivate(ctx context.Context, id string) (*razorpay.Contact, error) {
+	return getDefaultClient().Deactivate(ctx, id)
+}
+
+// NewClient returns new client.
+func NewClient(apiKey string, apiSecret string, apiBackend razorpay.Backend) *Client {
+	return &Client{razorpay.NewClient(apiKey, apiSecret, apiBackend)}
+}
+
+func getDefaultClient() *Client {
+	return &Client{razorpay.GetDefaultClient()}
+}
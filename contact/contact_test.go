@@ -0,0 +1,63 @@
+package contact
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	faker "github.com/bxcodec/faker/v3"
+	razorpay "github.com/jitendra-1217/razorpay-go"
+	"github.com/jitendra-1217/razorpay-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	// contactID holds new contact id created in Create test.
+	contactID string
+)
+
+func TestClient_Create(t *testing.T) {
+	name := faker.Name()
+	email := strings.ToLower(faker.Email())
+	params := &razorpay.ContactParams{
+		Name:  &name,
+		Email: &email,
+		Type:  razorpay.ContactTypeVendor.Ptr(),
+	}
+	contact, err := Create(context.Background(), params)
+	// For use in later tests.
+	contactID = contact.ID
+	assert.Nil(t, err)
+	assert.True(t, testutil.IsAnyID(contact.ID))
+	assert.Equal(t, name, contact.Name)
+	assert.Equal(t, email, contact.Email)
+	assert.Equal(t, razorpay.ContactTypeVendor, contact.Type)
+	assert.True(t, contact.Active)
+}
+
+func TestClient_Update(t *testing.T) {
+	email := strings.ToLower(faker.Email())
+	contact, err := Update(context.Background(), contactID, &razorpay.ContactParams{Email: &email})
+	assert.Nil(t, err)
+	assert.Equal(t, email, contact.Email)
+}
+
+func TestClient_Get(t *testing.T) {
+	contact, err := Get(context.Background(), contactID)
+	assert.Nil(t, err)
+	assert.Equal(t, contactID, contact.ID)
+}
+
+func TestClient_List(t *testing.T) {
+	_, err := List(context.Background(), nil)
+	assert.Nil(t, err)
+}
+
+func TestClient_Deactivate(t *testing.T) {
+	contact, err := Deactivate(context.Background(), contactID)
+	assert.Nil(t, err)
+	assert.False(t, contact.Active)
+	contact, err = Activate(context.Background(), contactID)
+	assert.Nil(t, err)
+	assert.True(t, contact.Active)
+}
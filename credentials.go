@@ -0,0 +1,189 @@
+package razorpay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultAPIKeyEnv is env var read by EnvCredentialsProvider by default.
+	defaultAPIKeyEnv = "RAZORPAY_KEY_ID"
+
+	// defaultAPISecretEnv is env var read by EnvCredentialsProvider by default.
+	defaultAPISecretEnv = "RAZORPAY_KEY_SECRET"
+)
+
+// Credentials is api key and secret pair.
+type Credentials struct {
+	APIKey    string `json:"api_key"`
+	APISecret string `json:"api_secret"`
+
+	// PreviousAPISecret is secret before the last rotation. It is set by
+	// providers tracking rotation, for the rotation window, so that signatures
+	// computed using old secret are still considered valid.
+	PreviousAPISecret string `json:"-"`
+}
+
+// CredentialsProvider provides credentials. It is consulted on each request
+// and so credentials can change e.g. on rotation, without rebuilding client.
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (*Credentials, error)
+}
+
+// StaticCredentialsProvider implements CredentialsProvider with fixed
+// credentials.
+type StaticCredentialsProvider struct {
+	APIKey    string
+	APISecret string
+}
+
+// Credentials returns fixed credentials.
+func (p *StaticCredentialsProvider) Credentials(_ context.Context) (*Credentials, error) {
+	return &Credentials{APIKey: p.APIKey, APISecret: p.APISecret}, nil
+}
+
+// EnvCredentialsProvider implements CredentialsProvider reading credentials
+// from env vars. Env vars default to RAZORPAY_KEY_ID and RAZORPAY_KEY_SECRET.
+type EnvCredentialsProvider struct {
+	APIKeyEnv    string
+	APISecretEnv string
+}
+
+// Credentials returns credentials read from env vars.
+func (p *EnvCredentialsProvider) Credentials(_ context.Context) (*Credentials, error) {
+	apiKeyEnv, apiSecretEnv := defaultAPIKeyEnv, defaultAPISecretEnv
+	if p.APIKeyEnv != "" {
+		apiKeyEnv = p.APIKeyEnv
+	}
+	if p.APISecretEnv != "" {
+		apiSecretEnv = p.APISecretEnv
+	}
+
+	credentials := &Credentials{APIKey: os.Getenv(apiKeyEnv), APISecret: os.Getenv(apiSecretEnv)}
+	if credentials.APIKey == "" || credentials.APISecret == "" {
+		return nil, fmt.Errorf("%s or %s env is not set", apiKeyEnv, apiSecretEnv)
+	}
+	return credentials, nil
+}
+
+// FileCredentialsProvider implements CredentialsProvider reading credentials
+// from json file i.e. `{"api_key": "...", "api_secret": "..."}`, for example
+// rendered by vault agent. File is read again whenever it changes.
+type FileCredentialsProvider struct {
+	Path string
+
+	// RotationWindow is duration for which previous secret is retained after
+	// file changes.
+	RotationWindow time.Duration
+
+	mu          sync.Mutex
+	modTime     time.Time
+	size        int64
+	credentials *Credentials
+	rotation    rotation
+}
+
+// Credentials returns credentials read from file.
+func (p *FileCredentialsProvider) Credentials(_ context.Context) (*Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	info, err := os.Stat(p.Path)
+	if err != nil {
+		return nil, err
+	}
+	if p.credentials == nil || !info.ModTime().Equal(p.modTime) || info.Size() != p.size {
+		data, err := ioutil.ReadFile(p.Path)
+		if err != nil {
+			return nil, err
+		}
+		credentials := &Credentials{}
+		if err := json.Unmarshal(data, credentials); err != nil {
+			return nil, err
+		}
+		p.credentials, p.modTime, p.size = credentials, info.ModTime(), info.Size()
+	}
+
+	return p.rotation.track(p.credentials, p.RotationWindow), nil
+}
+
+// CachingCredentialsProvider wraps CredentialsProvider and caches credentials
+// for TTL, for example when provider fetches credentials from remote. Cache is
+// also refreshed when remote responds with 401, see Refresh.
+type CachingCredentialsProvider struct {
+	Provider CredentialsProvider
+	TTL      time.Duration
+
+	// RotationWindow is duration for which previous secret is retained after
+	// provider starts returning new secret.
+	RotationWindow time.Duration
+
+	mu          sync.Mutex
+	expiresAt   time.Time
+	credentials *Credentials
+	rotation    rotation
+}
+
+// Credentials returns cached credentials, fetching from provider on expiry.
+func (p *CachingCredentialsProvider) Credentials(ctx context.Context) (*Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.credentials == nil || !time.Now().Before(p.expiresAt) {
+		credentials, err := p.Provider.Credentials(ctx)
+		if err != nil {
+			// Falls back to cached credentials, if any, when provider fails.
+			if p.credentials != nil {
+				return p.rotation.track(p.credentials, p.RotationWindow), nil
+			}
+			return nil, err
+		}
+		p.credentials, p.expiresAt = credentials, time.Now().Add(p.TTL)
+	}
+
+	return p.rotation.track(p.credentials, p.RotationWindow), nil
+}
+
+// Refresh expires cached credentials, unless params were authenticated with
+// other credentials i.e. cache is already refreshed, so that next request
+// fetches from provider e.g. after secret is rotated.
+func (p *CachingCredentialsProvider) Refresh(_ context.Context, params RequestParams) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	authorization := ""
+	if params != nil {
+		authorization = params.Headers()["Authorization"]
+	}
+	if p.credentials != nil && authorization != "" && authorization != basicAuthorization(p.credentials) {
+		return nil
+	}
+	p.expiresAt = time.Time{}
+	return nil
+}
+
+// rotation tracks secret changes and retains previous secret for a window.
+type rotation struct {
+	secret         string
+	previousSecret string
+	rotatedAt      time.Time
+}
+
+// track returns copy of credentials with previous secret set, if within window.
+func (r *rotation) track(credentials *Credentials, window time.Duration) *Credentials {
+	if r.secret != "" && r.secret != credentials.APISecret {
+		r.previousSecret, r.rotatedAt = r.secret, time.Now()
+	}
+	r.secret = credentials.APISecret
+
+	tracked := *credentials
+	if r.previousSecret != "" && time.Since(r.rotatedAt) < window {
+		tracked.PreviousAPISecret = r.previousSecret
+	}
+	return &tracked
+}
@@ -0,0 +1,124 @@
+package razorpay
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCachingCredentialsProvider(t *testing.T) {
+	provider := &StaticCredentialsProvider{"KEY", "OLD_SECRET"}
+	cachingProvider := &CachingCredentialsProvider{Provider: provider, TTL: 0, RotationWindow: time.Hour}
+	client := NewClientWithCredentialsProvider(cachingProvider, nil)
+
+	params := map[string]string{
+		"razorpay_order_id":   "order_00000000000001",
+		"razorpay_payment_id": "pay_00000000000001",
+	}
+	oldSignature := sign("order_00000000000001|pay_00000000000001", "OLD_SECRET")
+	newSignature := sign("order_00000000000001|pay_00000000000001", "NEW_SECRET")
+
+	// Case: Before rotation, only old secret is accepted.
+	params["razorpay_signature"] = oldSignature
+	isValid, err := client.IsValidPaymentSignature(context.Background(), params)
+	assert.Nil(t, err)
+	assert.True(t, isValid)
+	params["razorpay_signature"] = newSignature
+	isValid, _ = client.IsValidPaymentSignature(context.Background(), params)
+	assert.False(t, isValid)
+
+	// Case: Within rotation window, both old and new secret are accepted.
+	provider.APISecret = "NEW_SECRET"
+	isValid, _ = client.IsValidPaymentSignature(context.Background(), params)
+	assert.True(t, isValid)
+	params["razorpay_signature"] = oldSignature
+	isValid, _ = client.IsValidPaymentSignature(context.Background(), params)
+	assert.True(t, isValid)
+
+	// Case: After rotation window, only new secret is accepted.
+	cachingProvider.RotationWindow = 0
+	isValid, _ = client.IsValidPaymentSignature(context.Background(), params)
+	assert.False(t, isValid)
+}
+
+func TestCachingCredentialsProvider_Refresh(t *testing.T) {
+	// Mocks api host which accepts only new secret.
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if _, secret, _ := r.BasicAuth(); secret != "NEW_SECRET" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"code":"BAD_REQUEST_ERROR","description":"Authentication failed"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"order_00000000000001"}`))
+	}))
+	defer server.Close()
+	backend := &APIBackend{Host: server.URL, HTTPClient: server.Client()}
+
+	// Case: Rotated secret is picked on 401, before expiry of cache.
+	provider := &StaticCredentialsProvider{"KEY", "OLD_SECRET"}
+	cachingProvider := &CachingCredentialsProvider{Provider: provider, TTL: time.Hour}
+	_, _ = cachingProvider.Credentials(context.Background())
+	provider.APISecret = "NEW_SECRET"
+	client := NewClientWithCredentialsProvider(cachingProvider, backend)
+	order := &Order{}
+	err := client.Call(context.Background(), http.MethodGet, "/orders/order_00000000000001", nil, order)
+	assert.Nil(t, err)
+	assert.Equal(t, "order_00000000000001", order.ID)
+	assert.Equal(t, 2, requests)
+
+	// Case: Refresh for request sent with replaced credentials keeps cache.
+	params := &Params{}
+	params.SetHeader("Authorization", basicAuthorization(&Credentials{APIKey: "KEY", APISecret: "OLD_SECRET"}))
+	provider.APISecret = "NEWER_SECRET"
+	assert.Nil(t, cachingProvider.Refresh(context.Background(), params))
+	credentials, _ := cachingProvider.Credentials(context.Background())
+	assert.Equal(t, "NEW_SECRET", credentials.APISecret)
+
+	// Case: Request is not retried when provider can not be refreshed.
+	requests = 0
+	client = NewClient("KEY", "OLD_SECRET", backend)
+	err = client.Call(context.Background(), http.MethodGet, "/orders/order_00000000000001", nil, &Order{})
+	razorpayErr, ok := err.(*Error)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusUnauthorized, razorpayErr.StatusCode)
+	assert.Equal(t, 1, requests)
+}
+
+func TestFileCredentialsProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "razorpay")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "credentials.json")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`{"api_key":"KEY","api_secret":"SECRET"}`), 0600))
+	provider := &FileCredentialsProvider{Path: path, RotationWindow: time.Hour}
+	credentials, err := provider.Credentials(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "KEY", credentials.APIKey)
+	assert.Equal(t, "SECRET", credentials.APISecret)
+
+	// Case: When file changes, new secret is read and old one is retained.
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`{"api_key":"KEY","api_secret":"ROTATED_SECRET"}`), 0600))
+	credentials, err = provider.Credentials(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "ROTATED_SECRET", credentials.APISecret)
+	assert.Equal(t, "SECRET", credentials.PreviousAPISecret)
+}
+
+func sign(payload string, secret string) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(payload)) //nolint
+	return hex.EncodeToString(h.Sum(nil))
+}
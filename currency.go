@@ -0,0 +1,251 @@
+package razorpay
+
+// Currency is ISO 4217 currency code. Unknown values are retained as is when
+// unmarshalling, and so IsValid can be used to detect them.
+type Currency string
+
+// List of currencies supported by Razorpay.
+const (
+	CurrencyAED Currency = "AED"
+	CurrencyALL Currency = "ALL"
+	CurrencyAMD Currency = "AMD"
+	CurrencyARS Currency = "ARS"
+	CurrencyAUD Currency = "AUD"
+	CurrencyAWG Currency = "AWG"
+	CurrencyBBD Currency = "BBD"
+	CurrencyBDT Currency = "BDT"
+	CurrencyBHD Currency = "BHD"
+	CurrencyBMD Currency = "BMD"
+	CurrencyBND Currency = "BND"
+	CurrencyBOB Currency = "BOB"
+	CurrencyBSD Currency = "BSD"
+	CurrencyBWP Currency = "BWP"
+	CurrencyBZD Currency = "BZD"
+	CurrencyCAD Currency = "CAD"
+	CurrencyCHF Currency = "CHF"
+	CurrencyCLP Currency = "CLP"
+	CurrencyCNY Currency = "CNY"
+	CurrencyCOP Currency = "COP"
+	CurrencyCRC Currency = "CRC"
+	CurrencyCUP Currency = "CUP"
+	CurrencyCZK Currency = "CZK"
+	CurrencyDKK Currency = "DKK"
+	CurrencyDOP Currency = "DOP"
+	CurrencyDZD Currency = "DZD"
+	CurrencyEGP Currency = "EGP"
+	CurrencyETB Currency = "ETB"
+	CurrencyEUR Currency = "EUR"
+	CurrencyFJD Currency = "FJD"
+	CurrencyGBP Currency = "GBP"
+	CurrencyGHS Currency = "GHS"
+	CurrencyGIP Currency = "GIP"
+	CurrencyGMD Currency = "GMD"
+	CurrencyGTQ Currency = "GTQ"
+	CurrencyGYD Currency = "GYD"
+	CurrencyHKD Currency = "HKD"
+	CurrencyHNL Currency = "HNL"
+	CurrencyHRK Currency = "HRK"
+	CurrencyHTG Currency = "HTG"
+	CurrencyHUF Currency = "HUF"
+	CurrencyIDR Currency = "IDR"
+	CurrencyILS Currency = "ILS"
+	CurrencyINR Currency = "INR"
+	CurrencyIQD Currency = "IQD"
+	CurrencyISK Currency = "ISK"
+	CurrencyJMD Currency = "JMD"
+	CurrencyJOD Currency = "JOD"
+	CurrencyJPY Currency = "JPY"
+	CurrencyKES Currency = "KES"
+	CurrencyKGS Currency = "KGS"
+	CurrencyKHR Currency = "KHR"
+	CurrencyKRW Currency = "KRW"
+	CurrencyKWD Currency = "KWD"
+	CurrencyKYD Currency = "KYD"
+	CurrencyKZT Currency = "KZT"
+	CurrencyLAK Currency = "LAK"
+	CurrencyLBP Currency = "LBP"
+	CurrencyLKR Currency = "LKR"
+	CurrencyLRD Currency = "LRD"
+	CurrencyLSL Currency = "LSL"
+	CurrencyLYD Currency = "LYD"
+	CurrencyMAD Currency = "MAD"
+	CurrencyMDL Currency = "MDL"
+	CurrencyMKD Currency = "MKD"
+	CurrencyMMK Currency = "MMK"
+	CurrencyMNT Currency = "MNT"
+	CurrencyMOP Currency = "MOP"
+	CurrencyMUR Currency = "MUR"
+	CurrencyMVR Currency = "MVR"
+	CurrencyMWK Currency = "MWK"
+	CurrencyMXN Currency = "MXN"
+	CurrencyMYR Currency = "MYR"
+	CurrencyNAD Currency = "NAD"
+	CurrencyNGN Currency = "NGN"
+	CurrencyNIO Currency = "NIO"
+	CurrencyNOK Currency = "NOK"
+	CurrencyNPR Currency = "NPR"
+	CurrencyNZD Currency = "NZD"
+	CurrencyOMR Currency = "OMR"
+	CurrencyPEN Currency = "PEN"
+	CurrencyPGK Currency = "PGK"
+	CurrencyPHP Currency = "PHP"
+	CurrencyPKR Currency = "PKR"
+	CurrencyPYG Currency = "PYG"
+	CurrencyQAR Currency = "QAR"
+	CurrencyRUB Currency = "RUB"
+	CurrencySAR Currency = "SAR"
+	CurrencySCR Currency = "SCR"
+	CurrencySEK Currency = "SEK"
+	CurrencySGD Currency = "SGD"
+	CurrencySLL Currency = "SLL"
+	CurrencySOS Currency = "SOS"
+	CurrencySSP Currency = "SSP"
+	CurrencySVC Currency = "SVC"
+	CurrencySZL Currency = "SZL"
+	CurrencyTHB Currency = "THB"
+	CurrencyTND Currency = "TND"
+	CurrencyTTD Currency = "TTD"
+	CurrencyTZS Currency = "TZS"
+	CurrencyUGX Currency = "UGX"
+	CurrencyUSD Currency = "USD"
+	CurrencyUYU Currency = "UYU"
+	CurrencyUZS Currency = "UZS"
+	CurrencyVND Currency = "VND"
+	CurrencyXAF Currency = "XAF"
+	CurrencyXOF Currency = "XOF"
+	CurrencyYER Currency = "YER"
+	CurrencyZAR Currency = "ZAR"
+)
+
+// currencyExponents is set of supported currencies with their exponent i.e.
+// number of digits after decimal separator of minor unit, as per ISO 4217.
+var currencyExponents = map[Currency]int{
+	CurrencyAED: 2,
+	CurrencyALL: 2,
+	CurrencyAMD: 2,
+	CurrencyARS: 2,
+	CurrencyAUD: 2,
+	CurrencyAWG: 2,
+	CurrencyBBD: 2,
+	CurrencyBDT: 2,
+	CurrencyBHD: 3,
+	CurrencyBMD: 2,
+	CurrencyBND: 2,
+	CurrencyBOB: 2,
+	CurrencyBSD: 2,
+	CurrencyBWP: 2,
+	CurrencyBZD: 2,
+	CurrencyCAD: 2,
+	CurrencyCHF: 2,
+	CurrencyCLP: 0,
+	CurrencyCNY: 2,
+	CurrencyCOP: 2,
+	CurrencyCRC: 2,
+	CurrencyCUP: 2,
+	CurrencyCZK: 2,
+	CurrencyDKK: 2,
+	CurrencyDOP: 2,
+	CurrencyDZD: 2,
+	CurrencyEGP: 2,
+	CurrencyETB: 2,
+	CurrencyEUR: 2,
+	CurrencyFJD: 2,
+	CurrencyGBP: 2,
+	CurrencyGHS: 2,
+	CurrencyGIP: 2,
+	CurrencyGMD: 2,
+	CurrencyGTQ: 2,
+	CurrencyGYD: 2,
+	CurrencyHKD: 2,
+	CurrencyHNL: 2,
+	CurrencyHRK: 2,
+	CurrencyHTG: 2,
+	CurrencyHUF: 2,
+	CurrencyIDR: 2,
+	CurrencyILS: 2,
+	CurrencyINR: 2,
+	CurrencyIQD: 3,
+	CurrencyISK: 0,
+	CurrencyJMD: 2,
+	CurrencyJOD: 3,
+	CurrencyJPY: 0,
+	CurrencyKES: 2,
+	CurrencyKGS: 2,
+	CurrencyKHR: 2,
+	CurrencyKRW: 0,
+	CurrencyKWD: 3,
+	CurrencyKYD: 2,
+	CurrencyKZT: 2,
+	CurrencyLAK: 2,
+	CurrencyLBP: 2,
+	CurrencyLKR: 2,
+	CurrencyLRD: 2,
+	CurrencyLSL: 2,
+	CurrencyLYD: 3,
+	CurrencyMAD: 2,
+	CurrencyMDL: 2,
+	CurrencyMKD: 2,
+	CurrencyMMK: 2,
+	CurrencyMNT: 2,
+	CurrencyMOP: 2,
+	CurrencyMUR: 2,
+	CurrencyMVR: 2,
+	CurrencyMWK: 2,
+	CurrencyMXN: 2,
+	CurrencyMYR: 2,
+	CurrencyNAD: 2,
+	CurrencyNGN: 2,
+	CurrencyNIO: 2,
+	CurrencyNOK: 2,
+	CurrencyNPR: 2,
+	CurrencyNZD: 2,
+	CurrencyOMR: 3,
+	CurrencyPEN: 2,
+	CurrencyPGK: 2,
+	CurrencyPHP: 2,
+	CurrencyPKR: 2,
+	CurrencyPYG: 0,
+	CurrencyQAR: 2,
+	CurrencyRUB: 2,
+	CurrencySAR: 2,
+	CurrencySCR: 2,
+	CurrencySEK: 2,
+	CurrencySGD: 2,
+	CurrencySLL: 2,
+	CurrencySOS: 2,
+	CurrencySSP: 2,
+	CurrencySVC: 2,
+	CurrencySZL: 2,
+	CurrencyTHB: 2,
+	CurrencyTND: 3,
+	CurrencyTTD: 2,
+	CurrencyTZS: 2,
+	CurrencyUGX: 0,
+	CurrencyUSD: 2,
+	CurrencyUYU: 2,
+	CurrencyUZS: 2,
+	CurrencyVND: 0,
+	CurrencyXAF: 0,
+	CurrencyXOF: 0,
+	CurrencyYER: 2,
+	CurrencyZAR: 2,
+}
+
+// IsValid returns if currency is one of supported currencies.
+func (c Currency) IsValid() bool {
+	_, ok := currencyExponents[c]
+	return ok
+}
+
+// Exponent returns number of digits after decimal separator of minor unit
+// e.g. 2 for INR, 0 for JPY and 3 for KWD. It returns false if currency is
+// not supported.
+func (c Currency) Exponent() (int, bool) {
+	exponent, ok := currencyExponents[c]
+	return exponent, ok
+}
+
+// Ptr returns pointer to currency value.
+func (c Currency) Ptr() *Currency {
+	return &c
+}
@@ -0,0 +1,36 @@
+package razorpay
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrency_IsValid(t *testing.T) {
+	assert.True(t, CurrencyINR.IsValid())
+	assert.True(t, Currency("KWD").IsValid())
+	assert.False(t, Currency("INRR").IsValid())
+	assert.False(t, Currency("").IsValid())
+}
+
+func TestEnums_UnmarshalJSON(t *testing.T) {
+	// Case: Known values.
+	payment := &Payment{}
+	err := json.Unmarshal([]byte(`{"currency":"INR","status":"captured","method":"upi"}`), payment)
+	assert.Nil(t, err)
+	assert.Equal(t, CurrencyINR, payment.Currency)
+	assert.Equal(t, PaymentStatusCaptured, payment.Status)
+	assert.Equal(t, PaymentMethodUPI, payment.Method)
+	assert.True(t, payment.Status.IsValid())
+	assert.True(t, payment.Method.IsValid())
+
+	// Case: Unknown values are retained, not failing unmarshalling.
+	refund := &Refund{}
+	err = json.Unmarshal([]byte(`{"status":"reversed","speed_requested":"optimum","speed_processed":"turbo"}`), refund)
+	assert.Nil(t, err)
+	assert.Equal(t, RefundStatus("reversed"), refund.Status)
+	assert.False(t, refund.Status.IsValid())
+	assert.Equal(t, RefundSpeedOptimum, refund.SpeedRequested)
+	assert.False(t, refund.SpeedProcessed.IsValid())
+}
@@ -26,6 +26,10 @@ type CustomerParams struct {
 	Email   *string `json:"email,omitempty"`
 	Gstin   *string `json:"gstin,omitempty"`
 	Notes   Notes   `json:"notes,omitempty"`
+
+	// FailExisting is "0" to return existing customer with same email and
+	// contact instead of failing, on create. Default is "1".
+	FailExisting *string `json:"fail_existing,omitempty"`
 }
 
 // CustomerListParams is list params that can be used when listing customers.
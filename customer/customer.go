@@ -15,6 +15,9 @@ type Client struct {
 // Create creates new customer.
 func (c *Client) Create(ctx context.Context, params *razorpay.CustomerParams) (*razorpay.Customer, error) {
 	customer := &razorpay.Customer{}
+	if err := c.ValidateParams(params); err != nil {
+		return customer, err
+	}
 	err := c.Call(ctx, http.MethodPost, "/customers", params, customer)
 	return customer, err
 }
@@ -22,6 +25,9 @@ func (c *Client) Create(ctx context.Context, params *razorpay.CustomerParams) (*
 // Update updates existing customer.
 func (c *Client) Update(ctx context.Context, id string, params *razorpay.CustomerParams) (*razorpay.Customer, error) {
 	customer := &razorpay.Customer{}
+	if err := c.ValidateParams(params); err != nil {
+		return customer, err
+	}
 	err := c.Call(ctx, http.MethodPut, "/customers/"+id, params, customer)
 	return customer, err
 }
@@ -48,6 +54,27 @@ func (c *Client) List(ctx context.Context, params *razorpay.CustomerListParams)
 	return customerList, err
 }
 
+// Tokens returns list of tokens of customer.
+func (c *Client) Tokens(ctx context.Context, customerID string) (*razorpay.TokenList, error) {
+	tokenList := &razorpay.TokenList{}
+	err := c.Call(ctx, http.MethodGet, "/customers/"+customerID+"/tokens", nil, tokenList)
+	return tokenList, err
+}
+
+// GetToken returns token of customer for id.
+func (c *Client) GetToken(ctx context.Context, customerID string, tokenID string) (*razorpay.Token, error) {
+	token := &razorpay.Token{}
+	err := c.Call(ctx, http.MethodGet, "/customers/"+customerID+"/tokens/"+tokenID, nil, token)
+	return token, err
+}
+
+// DeleteToken deletes token of customer.
+func (c *Client) DeleteToken(ctx context.Context, customerID string, tokenID string) (*razorpay.TokenDeleteResponse, error) {
+	resp := &razorpay.TokenDeleteResponse{}
+	err := c.Call(ctx, http.MethodDelete, "/customers/"+customerID+"/tokens/"+tokenID, nil, resp)
+	return resp, err
+}
+
 // Create creates new customer.
 func Create(ctx context.Context, params *razorpay.CustomerParams) (*razorpay.Customer, error) {
 	return getDefaultClient().Create(ctx, params)
@@ -68,6 +95,21 @@ func List(ctx context.Context, params *razorpay.CustomerListParams) (*razorpay.C
 	return getDefaultClient().List(ctx, params)
 }
 
+// Tokens returns list of tokens of customer.
+func Tokens(ctx context.Context, customerID string) (*razorpay.TokenList, error) {
+	return getDefaultClient().Tokens(ctx, customerID)
+}
+
+// GetToken returns token of customer for id.
+func GetToken(ctx context.Context, customerID string, tokenID string) (*razorpay.Token, error) {
+	return getDefaultClient().GetToken(ctx, customerID, tokenID)
+}
+
+// DeleteToken deletes token of customer.
+func DeleteToken(ctx context.Context, customerID string, tokenID string) (*razorpay.TokenDeleteResponse, error) {
+	return getDefaultClient().DeleteToken(ctx, customerID, tokenID)
+}
+
 // NewClient returns new client.
 func NewClient(apiKey string, apiSecret string, apiBackend razorpay.Backend) *Client {
 	return &Client{razorpay.NewClient(apiKey, apiSecret, apiBackend)}
@@ -60,3 +60,9 @@ func TestClient_List(t *testing.T) {
 	_, err := List(context.Background(), nil)
 	assert.Nil(t, err)
 }
+
+func TestClient_Tokens(t *testing.T) {
+	tokenList, err := Tokens(context.Background(), customerID)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), tokenList.Count)
+}
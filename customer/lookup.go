@@ -0,0 +1,127 @@
+package customer
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+)
+
+// lookupPageSize is number of customers listed per page, maximum allowed.
+const lookupPageSize = 100
+
+// ErrNotFound is returned when customer is not found on lookup.
+var ErrNotFound = errors.New("customer not found")
+
+// GetOrCreate creates new customer, or returns existing customer with same
+// email and contact as is.
+func (c *Client) GetOrCreate(ctx context.Context, params *razorpay.CustomerParams) (*razorpay.Customer, error) {
+	if params == nil {
+		params = &razorpay.CustomerParams{}
+	}
+	clone := *params
+	clone.FailExisting = razorpay.String("0")
+	return c.Create(ctx, &clone)
+}
+
+// Upsert creates new customer, or updates existing customer with same email
+// and contact if any of given fields differ.
+func (c *Client) Upsert(ctx context.Context, params *razorpay.CustomerParams) (*razorpay.Customer, error) {
+	if params == nil {
+		params = &razorpay.CustomerParams{}
+	}
+	customer, err := c.GetOrCreate(ctx, params)
+	if err != nil || !differs(customer, params) {
+		return customer, err
+	}
+	updateParams := *params
+	updateParams.FailExisting = nil
+	return c.Update(ctx, customer.ID, &updateParams)
+}
+
+// FindByEmail returns customer with email, matching case-insensitively. It
+// pages through all customers, and returns ErrNotFound if none matches.
+func (c *Client) FindByEmail(ctx context.Context, email string) (*razorpay.Customer, error) {
+	return c.find(ctx, func(customer *razorpay.Customer) bool {
+		return strings.EqualFold(customer.Email, strings.TrimSpace(email))
+	})
+}
+
+// FindByContact returns customer with contact, ignoring formatting e.g.
+// spaces and hyphens. It pages through all customers, and returns ErrNotFound
+// if none matches.
+func (c *Client) FindByContact(ctx context.Context, contact string) (*razorpay.Customer, error) {
+	contact = normalizeContact(contact)
+	return c.find(ctx, func(customer *razorpay.Customer) bool {
+		return contact != "" && normalizeContact(customer.Contact) == contact
+	})
+}
+
+// find returns first customer matching, listing page by page.
+func (c *Client) find(ctx context.Context, match func(*razorpay.Customer) bool) (*razorpay.Customer, error) {
+	params := &razorpay.CustomerListParams{}
+	params.Count = razorpay.Int64(lookupPageSize)
+	for skip := int64(0); ; skip += lookupPageSize {
+		params.Skip = razorpay.Int64(skip)
+		customerList, err := c.List(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, customer := range customerList.Customers {
+			if match(customer) {
+				return customer, nil
+			}
+		}
+		if len(customerList.Customers) < lookupPageSize {
+			return nil, ErrNotFound
+		}
+	}
+}
+
+// differs returns if any of the set params differs from customer.
+func differs(customer *razorpay.Customer, params *razorpay.CustomerParams) bool {
+	if (params.Name != nil && *params.Name != customer.Name) ||
+		(params.Email != nil && *params.Email != customer.Email) ||
+		(params.Contact != nil && *params.Contact != customer.Contact) ||
+		(params.Gstin != nil && *params.Gstin != customer.Gstin) {
+		return true
+	}
+	for key, value := range params.Notes {
+		if customer.Notes[key] != value {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeContact returns contact with only digits and leading +.
+func normalizeContact(contact string) string {
+	var b strings.Builder
+	for i, r := range strings.TrimSpace(contact) {
+		if (r >= '0' && r <= '9') || (r == '+' && i == 0) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// GetOrCreate creates new customer, or returns existing customer.
+func GetOrCreate(ctx context.Context, params *razorpay.CustomerParams) (*razorpay.Customer, error) {
+	return getDefaultClient().GetOrCreate(ctx, params)
+}
+
+// Upsert creates new customer, or updates existing customer.
+func Upsert(ctx context.Context, params *razorpay.CustomerParams) (*razorpay.Customer, error) {
+	return getDefaultClient().Upsert(ctx, params)
+}
+
+// FindByEmail returns customer with email.
+func FindByEmail(ctx context.Context, email string) (*razorpay.Customer, error) {
+	return getDefaultClient().FindByEmail(ctx, email)
+}
+
+// FindByContact returns customer with contact.
+func FindByContact(ctx context.Context, contact string) (*razorpay.Customer, error) {
+	return getDefaultClient().FindByContact(ctx, contact)
+}
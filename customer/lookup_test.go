@@ -0,0 +1,87 @@
+package customer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+	"github.com/jitendra-1217/razorpay-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Find(t *testing.T) {
+	// Lists 150 customers over two pages.
+	pages := 0
+	client := NewClient("key", "secret", testutil.BackendFunc(func(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error {
+		pages++
+		listParams := params.(*razorpay.CustomerListParams)
+		customerList := v.(*razorpay.CustomerList)
+		for i := *listParams.Skip; i < 150 && i < *listParams.Skip+*listParams.Count; i++ {
+			customerList.Customers = append(customerList.Customers, &razorpay.Customer{
+				Entity:  razorpay.Entity{ID: fmt.Sprintf("cust_%014d", i)},
+				Email:   fmt.Sprintf("user%d@example.com", i),
+				Contact: fmt.Sprintf("+9191234%05d", i),
+			})
+		}
+		return nil
+	}))
+
+	// Case: Found on second page.
+	customer, err := client.FindByEmail(context.Background(), "USER120@example.com")
+	assert.Nil(t, err)
+	assert.Equal(t, "cust_00000000000120", customer.ID)
+	assert.Equal(t, 2, pages)
+
+	// Case: Contact is matched ignoring formatting.
+	pages = 0
+	customer, err = client.FindByContact(context.Background(), "+91 91234-00007")
+	assert.Nil(t, err)
+	assert.Equal(t, "cust_00000000000007", customer.ID)
+	assert.Equal(t, 1, pages)
+
+	// Case: Not found.
+	_, err = client.FindByEmail(context.Background(), "missing@example.com")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestClient_Upsert(t *testing.T) {
+	existing := &razorpay.Customer{Entity: razorpay.Entity{ID: "cust_00000000000001"}, Name: "Gaurav", Email: "gaurav.kumar@example.com", Contact: "9123456789"}
+	calls := []string{}
+	client := NewClient("key", "secret", testutil.BackendFunc(func(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error {
+		calls = append(calls, method+" "+path)
+		customerParams := params.(*razorpay.CustomerParams)
+		customer := v.(*razorpay.Customer)
+		*customer = *existing
+		if method == http.MethodPost {
+			assert.Equal(t, "0", *customerParams.FailExisting)
+		} else {
+			assert.Nil(t, customerParams.FailExisting)
+			customer.Name = *customerParams.Name
+		}
+		return nil
+	}))
+
+	// Case: Existing customer is returned as is.
+	params := &razorpay.CustomerParams{Name: razorpay.String("Gaurav"), Email: razorpay.String("gaurav.kumar@example.com")}
+	customer, err := client.Upsert(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"POST v1/customers"}, calls)
+	assert.Equal(t, "cust_00000000000001", customer.ID)
+	assert.Nil(t, params.FailExisting)
+
+	// Case: Existing customer is updated when fields differ.
+	calls = []string{}
+	params.Name = razorpay.String("Gaurav Kumar")
+	customer, err = client.Upsert(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"POST v1/customers", "PUT v1/customers/cust_00000000000001"}, calls)
+	assert.Equal(t, "Gaurav Kumar", customer.Name)
+
+	// Case: Nil params.
+	calls = []string{}
+	_, err = client.Upsert(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"POST v1/customers"}, calls)
+}
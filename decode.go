@@ -0,0 +1,324 @@
+package razorpay
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	unmarshalerType    = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	tolerantShaperType = reflect.TypeOf((*tolerantShaper)(nil)).Elem()
+
+	// structFieldsCache caches json fields per struct type.
+	structFieldsCache sync.Map
+)
+
+// DecodeWarning is a value in response which did not match type of the
+// field, and was skipped or coerced instead of failing whole response.
+type DecodeWarning struct {
+	Field   string
+	Message string
+}
+
+// Error returns one-liner warning string.
+func (w *DecodeWarning) Error() string {
+	return fmt.Sprintf("field: %s, message: %s", w.Field, w.Message)
+}
+
+// UnmarshalTolerant unmarshals json data into v, like json.Unmarshal, but
+// tolerates Razorpay's inconsistent shapes instead of failing:
+//   - `[]` or null where object is expected, and `{}` where list is expected,
+//     are treated as empty.
+//   - numbers as strings, and vice versa, are coerced to type of the field.
+//   - values which can not be coerced are skipped and returned as warnings.
+//
+// Types implementing json.Unmarshaler handle their own values, except for the
+// ones in this package which unmarshal tolerantly themselves, whose warnings
+// are returned too. Error is returned only when data is not valid json.
+func UnmarshalTolerant(data []byte, v interface{}) ([]*DecodeWarning, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return nil, fmt.Errorf("cannot unmarshal into non-pointer %T", v)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var tree interface{}
+	if err := decoder.Decode(&tree); err != nil {
+		return nil, err
+	}
+
+	d := &tolerantDecoder{}
+	normalized, err := json.Marshal(d.normalize(tree, rv.Type().Elem(), ""))
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(normalized, v)
+	// Type errors are unlikely after normalization, but json.Unmarshal still
+	// completes rest of the value in that case and so it is a warning as well.
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		d.warn(typeErr.Field, "cannot unmarshal %s into %s", typeErr.Value, typeErr.Type)
+		err = nil
+	}
+	return d.warnings, err
+}
+
+// tolerantShaper is implemented by types whose UnmarshalJSON unmarshals
+// tolerantly into another type i.e. shape. Decoder normalizes their values
+// against shape, so that warnings of nested values are not lost.
+type tolerantShaper interface {
+	tolerantShape() reflect.Type
+}
+
+// tolerantDecoder normalizes decoded json tree to match target type.
+type tolerantDecoder struct {
+	warnings []*DecodeWarning
+}
+
+func (d *tolerantDecoder) warn(field string, format string, args ...interface{}) {
+	d.warnings = append(d.warnings, &DecodeWarning{field, fmt.Sprintf(format, args...)})
+}
+
+// normalize returns value coerced to match type t. Returns nil for values
+// which can not be coerced, which leaves the field as is on unmarshal.
+func (d *tolerantDecoder) normalize(value interface{}, t reflect.Type, path string) interface{} {
+	if value == nil {
+		return nil
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(tolerantShaperType) {
+		shape := reflect.New(t).Interface().(tolerantShaper).tolerantShape()
+		return d.normalize(value, shape, path)
+	}
+	if t.Implements(unmarshalerType) || reflect.PtrTo(t).Implements(unmarshalerType) {
+		return value
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := d.object(value, path)
+		if !ok {
+			return nil
+		}
+		fields := structFields(t)
+		for key, fieldValue := range object {
+			if fieldType, ok := lookupField(fields, key); ok {
+				object[key] = d.normalize(fieldValue, fieldType, join(path, key))
+			}
+		}
+		return object
+
+	case reflect.Map:
+		object, ok := d.object(value, path)
+		if !ok {
+			return nil
+		}
+		for key, elemValue := range object {
+			object[key] = d.normalize(elemValue, t.Elem(), join(path, key))
+		}
+		return object
+
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return value
+		}
+		list, ok := value.([]interface{})
+		if !ok {
+			// In json response, an empty list often appears as `{}`.
+			if object, ok := value.(map[string]interface{}); !ok || len(object) > 0 {
+				d.warn(path, "expected list, got %s", jsonKind(value))
+			}
+			return nil
+		}
+		for i, elemValue := range list {
+			list[i] = d.normalize(elemValue, t.Elem(), join(path, strconv.Itoa(i)))
+		}
+		return list
+
+	case reflect.String:
+		switch v := value.(type) {
+		case string:
+			return v
+		case json.Number:
+			return v.String()
+		case bool:
+			return strconv.FormatBool(v)
+		default:
+			raw, _ := json.Marshal(v)
+			d.warn(path, "expected string, got %s", jsonKind(value))
+			return string(raw)
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, ok := d.number(value, path)
+		if !ok {
+			return nil
+		}
+		if _, err := strconv.ParseInt(number, 10, t.Bits()); err == nil {
+			return json.Number(number)
+		}
+		// Accepts integral floats e.g. `100.0`.
+		if f, err := strconv.ParseFloat(number, 64); err == nil && f == math.Trunc(f) && math.Abs(f) < math.Pow(2, float64(t.Bits()-1)) {
+			return json.Number(strconv.FormatInt(int64(f), 10))
+		}
+		d.warn(path, "expected integer, got %q", number)
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number, ok := d.number(value, path)
+		if !ok {
+			return nil
+		}
+		if _, err := strconv.ParseUint(number, 10, t.Bits()); err == nil {
+			return json.Number(number)
+		}
+		d.warn(path, "expected unsigned integer, got %q", number)
+		return nil
+
+	case reflect.Float32, reflect.Float64:
+		number, ok := d.number(value, path)
+		if !ok {
+			return nil
+		}
+		if _, err := strconv.ParseFloat(number, t.Bits()); err == nil {
+			return json.Number(number)
+		}
+		d.warn(path, "expected number, got %q", number)
+		return nil
+
+	case reflect.Bool:
+		switch v := value.(type) {
+		case bool:
+			return v
+		case string, json.Number:
+			if b, err := strconv.ParseBool(fmt.Sprint(v)); err == nil {
+				return b
+			}
+		}
+		d.warn(path, "expected boolean, got %s", jsonKind(value))
+		return nil
+	}
+
+	return value
+}
+
+// object returns value as object. In json response, an empty object often
+// appears as `[]`, which is treated as empty without warning.
+func (d *tolerantDecoder) object(value interface{}, path string) (map[string]interface{}, bool) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		if list, ok := value.([]interface{}); !ok || len(list) > 0 {
+			d.warn(path, "expected object, got %s", jsonKind(value))
+		}
+	}
+	return object, ok
+}
+
+// number returns value as number string, accepting numbers as strings.
+func (d *tolerantDecoder) number(value interface{}, path string) (string, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		return v.String(), true
+	case string:
+		if s := strings.TrimSpace(v); s != "" {
+			return s, true
+		}
+		return "", false
+	}
+	d.warn(path, "expected number, got %s", jsonKind(value))
+	return "", false
+}
+
+// structFields returns json field name to type mapping of struct, including
+// fields of embedded structs, similar to encoding/json.
+func structFields(t reflect.Type) map[string]reflect.Type {
+	if fields, ok := structFieldsCache.Load(t); ok {
+		return fields.(map[string]reflect.Type)
+	}
+
+	fields := map[string]reflect.Type{}
+	embedded := []map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, structFields(ft))
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	// Fields of outer struct take precedence over embedded ones.
+	for _, embeddedFields := range embedded {
+		for name, ft := range embeddedFields {
+			if _, ok := fields[name]; !ok {
+				fields[name] = ft
+			}
+		}
+	}
+
+	structFieldsCache.Store(t, fields)
+	return fields
+}
+
+// lookupField returns type of field by json key, matching case-insensitively
+// like encoding/json when there is no exact match.
+func lookupField(fields map[string]reflect.Type, key string) (reflect.Type, bool) {
+	if ft, ok := fields[key]; ok {
+		return ft, true
+	}
+	for name, ft := range fields {
+		if strings.EqualFold(name, key) {
+			return ft, true
+		}
+	}
+	return nil, false
+}
+
+func jsonKind(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "list"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	}
+	return "null"
+}
+
+func join(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
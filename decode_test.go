@@ -0,0 +1,67 @@
+package razorpay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalTolerant(t *testing.T) {
+	data := []byte(`{
+		"id": "pay_00000000000001",
+		"created_at": "1600000000",
+		"amount": "1000",
+		"fee": 23.0,
+		"tax": {"igst": 4},
+		"currency": "INR",
+		"email": 9876543210,
+		"notes": {"key": "value", "count": 2, "flag": true, "nested": {"a": 1}},
+		"items": {}
+	}`)
+	payment := &Payment{}
+	warnings, err := UnmarshalTolerant(data, payment)
+	assert.Nil(t, err)
+	assert.Equal(t, "pay_00000000000001", payment.ID)
+	assert.Equal(t, int64(1600000000), payment.CreatedAt)
+	assert.Equal(t, int64(1000), payment.Amount)
+	assert.Equal(t, int64(23), payment.Fee)
+	assert.Equal(t, int64(0), payment.Tax)
+	assert.Equal(t, "9876543210", payment.Email)
+	assert.Equal(t, Notes{"key": "value", "count": "2", "flag": "true", "nested": `{"a":1}`}, payment.Notes)
+	assert.ElementsMatch(t, []string{"tax", "notes.nested"}, warningFields(warnings))
+
+	// Case: Empty shapes.
+	paymentList := &PaymentList{}
+	warnings, err = UnmarshalTolerant([]byte(`{"count": 0, "items": {}}`), paymentList)
+	assert.Nil(t, err)
+	assert.Empty(t, warnings)
+	assert.Empty(t, paymentList.Payments)
+
+	// Case: Bad values nested in types with own unmarshalling.
+	paymentLink := &PaymentLink{}
+	warnings, err = UnmarshalTolerant([]byte(`{
+		"id": "plink_00000000000001",
+		"notes": {"key": "value"},
+		"reminders": {"status": {"sent": 1}},
+		"payments": [{"payment_id": "pay_00000000000001", "amount": "abc", "created_at": "1600000000"}]
+	}`), paymentLink)
+	assert.Nil(t, err)
+	assert.Equal(t, Notes{"key": "value"}, paymentLink.Notes)
+	assert.Len(t, paymentLink.Payments, 1)
+	assert.Equal(t, "pay_00000000000001", paymentLink.Payments[0].PaymentID)
+	assert.Equal(t, int64(0), paymentLink.Payments[0].Amount)
+	assert.Equal(t, int64(1600000000), paymentLink.Payments[0].CreatedAt)
+	assert.ElementsMatch(t, []string{"reminders.status", "payments.0.amount"}, warningFields(warnings))
+
+	// Case: Invalid json.
+	_, err = UnmarshalTolerant([]byte(`{"id":`), payment)
+	assert.NotNil(t, err)
+}
+
+func warningFields(warnings []*DecodeWarning) []string {
+	fields := []string{}
+	for _, warning := range warnings {
+		fields = append(fields, warning.Field)
+	}
+	return fields
+}
@@ -0,0 +1,119 @@
+package razorpay
+
+import "time"
+
+// Dispute is a Razorpay entity representation.
+type Dispute struct {
+	Response
+	Entity
+	PaymentID         string          `json:"payment_id"`
+	Amount            int64           `json:"amount"`
+	Currency          Currency        `json:"currency"`
+	AmountDeducted    int64           `json:"amount_deducted"`
+	ReasonCode        string          `json:"reason_code"`
+	ReasonDescription string          `json:"reason_description"`
+	RespondBy         int64           `json:"respond_by"`
+	Status            DisputeStatus   `json:"status"`
+	Phase             DisputePhase    `json:"phase"`
+	Comments          string          `json:"comments"`
+	Evidence          DisputeEvidence `json:"evidence"`
+}
+
+// IsActionable returns if dispute can still be accepted or contested.
+func (d *Dispute) IsActionable() bool {
+	return d.Status == DisputeStatusOpen && time.Now().Unix() < d.RespondBy
+}
+
+// TimeToRespond returns duration left until respond by deadline. It is
+// negative if deadline has passed.
+func (d *Dispute) TimeToRespond() time.Duration {
+	return time.Until(time.Unix(d.RespondBy, 0))
+}
+
+// DisputeEvidence is evidence submitted for dispute.
+type DisputeEvidence struct {
+	Amount                   int64                    `json:"amount"`
+	Summary                  string                   `json:"summary"`
+	ShippingProof            []string                 `json:"shipping_proof"`
+	BillingProof             []string                 `json:"billing_proof"`
+	CancellationProof        []string                 `json:"cancellation_proof"`
+	CustomerCommunication    []string                 `json:"customer_communication"`
+	ProofOfService           []string                 `json:"proof_of_service"`
+	ExplanationLetter        []string                 `json:"explanation_letter"`
+	RefundConfirmation       []string                 `json:"refund_confirmation"`
+	AccessActivityLog        []string                 `json:"access_activity_log"`
+	RefundCancellationPolicy []string                 `json:"refund_cancellation_policy"`
+	TermAndConditions        []string                 `json:"term_and_conditions"`
+	Others                   []*DisputeEvidenceOthers `json:"others"`
+	SubmittedAt              int64                    `json:"submitted_at"`
+}
+
+// DisputeEvidenceOthers is evidence documents of custom type.
+type DisputeEvidenceOthers struct {
+	Type        string   `json:"type"`
+	DocumentIDs []string `json:"document_ids"`
+}
+
+// DisputeStatus is status of dispute.
+type DisputeStatus string
+
+// List of dispute statuses.
+const (
+	DisputeStatusOpen        DisputeStatus = "open"
+	DisputeStatusUnderReview DisputeStatus = "under_review"
+	DisputeStatusWon         DisputeStatus = "won"
+	DisputeStatusLost        DisputeStatus = "lost"
+	DisputeStatusClosed      DisputeStatus = "closed"
+)
+
+// DisputePhase is phase of dispute.
+type DisputePhase string
+
+// List of dispute phases.
+const (
+	DisputePhaseFraud          DisputePhase = "fraud"
+	DisputePhaseRetrieval      DisputePhase = "retrieval"
+	DisputePhaseChargeback     DisputePhase = "chargeback"
+	DisputePhasePreArbitration DisputePhase = "pre_arbitration"
+	DisputePhaseArbitration    DisputePhase = "arbitration"
+)
+
+// DisputeList is collection of disputes.
+type DisputeList struct {
+	Response
+	EntityList
+	Disputes []*Dispute `json:"items"`
+}
+
+// DisputeContestParams is list of params that can be used when contesting
+// dispute. Evidence is list of document ids, refer DocumentParams.
+type DisputeContestParams struct {
+	Params
+	Amount                   *int64                         `json:"amount,omitempty"`
+	Summary                  *string                        `json:"summary,omitempty"`
+	ShippingProof            []string                       `json:"shipping_proof,omitempty"`
+	BillingProof             []string                       `json:"billing_proof,omitempty"`
+	CancellationProof        []string                       `json:"cancellation_proof,omitempty"`
+	CustomerCommunication    []string                       `json:"customer_communication,omitempty"`
+	ProofOfService           []string                       `json:"proof_of_service,omitempty"`
+	ExplanationLetter        []string                       `json:"explanation_letter,omitempty"`
+	RefundConfirmation       []string                       `json:"refund_confirmation,omitempty"`
+	AccessActivityLog        []string                       `json:"access_activity_log,omitempty"`
+	RefundCancellationPolicy []string                       `json:"refund_cancellation_policy,omitempty"`
+	TermAndConditions        []string                       `json:"term_and_conditions,omitempty"`
+	Others                   []*DisputeEvidenceOthersParams `json:"others,omitempty"`
+
+	// Action is either `draft` to save evidence, or `submit` to submit it.
+	Action *string `json:"action,omitempty"`
+}
+
+// DisputeEvidenceOthersParams is part of DisputeContestParams.
+type DisputeEvidenceOthersParams struct {
+	Type        *string  `json:"type,omitempty"`
+	DocumentIDs []string `json:"document_ids,omitempty"`
+}
+
+// DisputeListParams is list params that can be used when listing disputes.
+type DisputeListParams struct {
+	ListParams
+}
@@ -0,0 +1,97 @@
+package dispute
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+)
+
+// Client is used to access /disputes apis.
+type Client struct {
+	*razorpay.Client
+}
+
+// Get returns dispute for id.
+func (c *Client) Get(ctx context.Context, id string, params *razorpay.GetParams) (*razorpay.Dispute, error) {
+	if params == nil {
+		params = &razorpay.GetParams{}
+	}
+
+	dispute := &razorpay.Dispute{}
+	err := c.Call(ctx, http.MethodGet, "/disputes/"+id, params, dispute)
+	return dispute, err
+}
+
+// List returns list of disputes for params.
+func (c *Client) List(ctx context.Context, params *razorpay.DisputeListParams) (*razorpay.DisputeList, error) {
+	if params == nil {
+		params = &razorpay.DisputeListParams{}
+	}
+
+	disputeList := &razorpay.DisputeList{}
+	err := c.Call(ctx, http.MethodGet, "/disputes", params, disputeList)
+	return disputeList, err
+}
+
+// Accept accepts dispute i.e. disputed amount is not contested.
+func (c *Client) Accept(ctx context.Context, id string) (*razorpay.Dispute, error) {
+	dispute := &razorpay.Dispute{}
+	err := c.Call(ctx, http.MethodPost, "/disputes/"+id+"/accept", nil, dispute)
+	return dispute, err
+}
+
+// Contest contests dispute with evidence. Evidence documents are uploaded
+// first using UploadEvidence, and their ids are set in params.
+func (c *Client) Contest(ctx context.Context, id string, params *razorpay.DisputeContestParams) (*razorpay.Dispute, error) {
+	dispute := &razorpay.Dispute{}
+	err := c.Call(ctx, http.MethodPatch, "/disputes/"+id+"/contest", params, dispute)
+	return dispute, err
+}
+
+// UploadEvidence uploads evidence document, to later contest dispute with.
+func (c *Client) UploadEvidence(ctx context.Context, fileName string, file io.Reader) (*razorpay.Document, error) {
+	params := &razorpay.DocumentParams{
+		Purpose:  razorpay.String("dispute_evidence"),
+		FileName: fileName,
+		File:     file,
+	}
+	document := &razorpay.Document{}
+	err := c.Call(ctx, http.MethodPost, "/documents", params, document)
+	return document, err
+}
+
+// Get returns dispute for id.
+func Get(ctx context.Context, id string, params *razorpay.GetParams) (*razorpay.Dispute, error) {
+	return getDefaultClient().Get(ctx, id, params)
+}
+
+// List returns list of disputes for params.
+func List(ctx context.Context, params *razorpay.DisputeListParams) (*razorpay.DisputeList, error) {
+	return getDefaultClient().List(ctx, params)
+}
+
+// Accept accepts dispute i.e. disputed amount is not contested.
+func Accept(ctx context.Context, id string) (*razorpay.Dispute, error) {
+	return getDefaultClient().Accept(ctx, id)
+}
+
+// Contest contests dispute with evidence.
+func Contest(ctx context.Context, id string, params *razorpay.DisputeContestParams) (*razorpay.Dispute, error) {
+	return getDefaultClient().Contest(ctx, id, params)
+}
+
+// UploadEvidence uploads evidence document, to later contest dispute with.
+func UploadEvidence(ctx context.Context, fileName string, file io.Reader) (*razorpay.Document, error) {
+	return getDefaultClient().UploadEvidence(ctx, fileName, file)
+}
+
+// NewClient returns new client.
+func NewClient(apiKey string, apiSecret string, apiBackend razorpay.Backend) *Client {
+	return &Client{razorpay.NewClient(apiKey, apiSecret, apiBackend)}
+}
+
+func getDefaultClient() *Client {
+	return &Client{razorpay.GetDefaultClient()}
+}
@@ -0,0 +1,51 @@
+package dispute
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+	"github.com/jitendra-1217/razorpay-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	// disputeID holds an existing open dispute id.
+	disputeID = "disp_FtZlNRpVNpKyNr"
+
+	// documentID holds new evidence document id uploaded in UploadEvidence test.
+	documentID string
+)
+
+func TestClient_List(t *testing.T) {
+	disputeList, err := List(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.True(t, disputeList.Count > 0)
+}
+
+func TestClient_Get(t *testing.T) {
+	dispute, err := Get(context.Background(), disputeID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, disputeID, dispute.ID)
+}
+
+func TestClient_UploadEvidence(t *testing.T) {
+	document, err := UploadEvidence(context.Background(), "invoice.txt", strings.NewReader("Invoice #1"))
+	// For use in later tests.
+	documentID = document.ID
+	assert.Nil(t, err)
+	assert.True(t, testutil.IsAnyID(document.ID))
+	assert.Equal(t, "dispute_evidence", document.Purpose)
+}
+
+func TestClient_Contest(t *testing.T) {
+	params := &razorpay.DisputeContestParams{
+		Summary:      razorpay.String("Goods were delivered"),
+		BillingProof: []string{documentID},
+		Action:       razorpay.String("draft"),
+	}
+	dispute, err := Contest(context.Background(), disputeID, params)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{documentID}, dispute.Evidence.BillingProof)
+}
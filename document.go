@@ -0,0 +1,65 @@
+package razorpay
+
+import (
+	"fmt"
+	"io"
+)
+
+// Document is a Razorpay entity representation.
+type Document struct {
+	Response
+	Entity
+	Purpose  string `json:"purpose"`
+	Name     string `json:"name"`
+	MimeType string `json:"mime_type"`
+	Size     int64  `json:"size"`
+}
+
+// DocumentParams is list of params that can be used when uploading document.
+// It is sent as multipart form, streaming file from reader. Upload is retried
+// e.g. after refreshing credential only if reader is an io.Seeker.
+type DocumentParams struct {
+	Params
+	Purpose         *string
+	FileName        string
+	FileContentType string
+	File            io.Reader
+
+	// fileOffset is offset of file reader when first encoded, to rewind to.
+	fileOffset *int64
+}
+
+// EncodeBody encodes params as multipart form.
+func (p *DocumentParams) EncodeBody() (*RequestBody, error) {
+	if p.File == nil {
+		return nil, fmt.Errorf("file is missing in params")
+	}
+
+	if seeker, ok := p.File.(io.Seeker); ok && p.fileOffset == nil {
+		offset, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		p.fileOffset = &offset
+	}
+
+	fields := []*MultipartField{}
+	if p.Purpose != nil {
+		fields = append(fields, &MultipartField{"purpose", *p.Purpose})
+	}
+	files := []*MultipartFile{{FieldName: "file", FileName: p.FileName, ContentType: p.FileContentType, Reader: p.File}}
+	return EncodeMultipartBody(fields, files)
+}
+
+// RewindBody seeks file reader back to where it was when first encoded.
+func (p *DocumentParams) RewindBody() error {
+	seeker, ok := p.File.(io.Seeker)
+	if !ok {
+		return fmt.Errorf("file reader can not be rewound")
+	}
+	if p.fileOffset == nil {
+		return nil
+	}
+	_, err := seeker.Seek(*p.fileOffset, io.SeekStart)
+	return err
+}
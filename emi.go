@@ -0,0 +1,105 @@
+package razorpay
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// EMIPlan is emi plan offered by bank for a tenure.
+type EMIPlan struct {
+	// Duration is tenure in months.
+	Duration int64 `json:"duration"`
+	// Interest is annual rate of interest in percent.
+	Interest float64 `json:"interest"`
+	// MinAmount is minimum amount in minor unit for which plan is available.
+	MinAmount int64 `json:"min_amount"`
+	// Subvention is who bears interest i.e. customer or merchant.
+	Subvention      string  `json:"subvention"`
+	MerchantPayback float64 `json:"merchant_payback"`
+}
+
+// List of emi subventions.
+const (
+	EMISubventionCustomer = "customer"
+	EMISubventionMerchant = "merchant"
+)
+
+// EMICalculation is installments and interest of emi plan for an amount, in
+// minor unit.
+type EMICalculation struct {
+	Duration int64
+	// Installment is monthly installment, rounded up to minor unit.
+	Installment int64
+	// LastInstallment is last installment, lower than others by residue of
+	// rounding up.
+	LastInstallment int64
+	TotalAmount     int64
+	// TotalInterest is interest paid by customer, zero when merchant bears it.
+	TotalInterest int64
+	// MerchantInterest is interest borne by merchant, under subvention.
+	MerchantInterest int64
+}
+
+// Calculate returns monthly installments and total interest for amount in
+// minor unit e.g. Order.Amount, using reducing balance method. It returns
+// error if amount is less than minimum amount of plan.
+func (p *EMIPlan) Calculate(amount int64) (*EMICalculation, error) {
+	if p.Duration <= 0 {
+		return nil, fmt.Errorf("emi plan duration %d is invalid", p.Duration)
+	}
+	if amount < p.MinAmount {
+		return nil, fmt.Errorf("amount %d is less than minimum amount %d of emi plan", amount, p.MinAmount)
+	}
+
+	interest := emiTotal(amount, p.Duration, p.Interest) - amount
+	c := &EMICalculation{Duration: p.Duration}
+	// Customer pays amount without interest, when merchant bears it.
+	rate := p.Interest
+	if p.Subvention == EMISubventionMerchant {
+		rate = 0
+		c.MerchantInterest = interest
+	}
+	c.TotalAmount = emiTotal(amount, p.Duration, rate)
+	c.TotalInterest = c.TotalAmount - amount
+	c.Installment = int64(math.Ceil(float64(c.TotalAmount) / float64(p.Duration)))
+	c.LastInstallment = c.TotalAmount - c.Installment*(p.Duration-1)
+	return c, nil
+}
+
+// emiTotal returns total amount paid over duration months at annual rate of
+// interest, rounded to minor unit.
+func emiTotal(amount int64, duration int64, interest float64) int64 {
+	principal, n := float64(amount), float64(duration)
+	installment := principal / n
+	// Monthly rate of interest.
+	if r := interest / 12 / 100; r > 0 {
+		f := math.Pow(1+r, n)
+		installment = principal * r * f / (f - 1)
+	}
+	return int64(math.Round(installment * n))
+}
+
+// EMIPlans is emi plans keyed by bank code e.g. HDFC.
+type EMIPlans map[string][]*EMIPlan
+
+// ForBank returns plans of bank sorted by duration.
+func (p EMIPlans) ForBank(bank string) []*EMIPlan {
+	plans := append([]*EMIPlan{}, p[bank]...)
+	sort.SliceStable(plans, func(i, j int) bool {
+		return plans[i].Duration < plans[j].Duration
+	})
+	return plans
+}
+
+// Available returns plans of bank for which amount is eligible, sorted by
+// duration.
+func (p EMIPlans) Available(bank string, amount int64) []*EMIPlan {
+	plans := []*EMIPlan{}
+	for _, plan := range p.ForBank(bank) {
+		if amount >= plan.MinAmount {
+			plans = append(plans, plan)
+		}
+	}
+	return plans
+}
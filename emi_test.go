@@ -0,0 +1,47 @@
+package razorpay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEMIPlan_Calculate(t *testing.T) {
+	// Case: Reducing balance with interest.
+	plan := &EMIPlan{Duration: 3, Interest: 12, MinAmount: 300000}
+	c, err := plan.Calculate(300000)
+	assert.Nil(t, err)
+	assert.Equal(t, &EMICalculation{Duration: 3, Installment: 102007, LastInstallment: 102006, TotalAmount: 306020, TotalInterest: 6020}, c)
+
+	// Case: Zero interest, with rounding residue in last installment.
+	plan = &EMIPlan{Duration: 3, Interest: 0}
+	c, err = plan.Calculate(100000)
+	assert.Nil(t, err)
+	assert.Equal(t, &EMICalculation{Duration: 3, Installment: 33334, LastInstallment: 33332, TotalAmount: 100000, TotalInterest: 0}, c)
+
+	// Case: No cost emi, with interest borne by merchant.
+	plan = &EMIPlan{Duration: 3, Interest: 12, Subvention: EMISubventionMerchant}
+	c, err = plan.Calculate(300000)
+	assert.Nil(t, err)
+	assert.Equal(t, &EMICalculation{Duration: 3, Installment: 100000, LastInstallment: 100000, TotalAmount: 300000, TotalInterest: 0, MerchantInterest: 6020}, c)
+
+	// Case: Amount less than minimum.
+	plan = &EMIPlan{Duration: 6, Interest: 13, MinAmount: 300000}
+	_, err = plan.Calculate(299999)
+	assert.NotNil(t, err)
+}
+
+func TestEMIPlans_Available(t *testing.T) {
+	methods := &Methods{}
+	_, err := UnmarshalTolerant([]byte(`{"emi_options": {"HDFC": [
+		{"duration": 9, "interest": 13, "min_amount": 300000, "subvention": "customer"},
+		{"duration": 3, "interest": "12", "min_amount": 100000, "subvention": "customer"}
+	]}}`), methods)
+	assert.Nil(t, err)
+	plans := methods.EMIOptions.Available("HDFC", 200000)
+	assert.Len(t, plans, 1)
+	assert.Equal(t, int64(3), plans[0].Duration)
+	assert.Equal(t, float64(12), plans[0].Interest)
+	assert.Len(t, methods.EMIOptions.ForBank("HDFC"), 2)
+	assert.Len(t, methods.EMIOptions.Available("ICIC", 200000), 0)
+}
@@ -0,0 +1,84 @@
+package razorpay
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// unmarshalFixture unmarshals fixture from testdata into v, asserting that it
+// has no warnings and that every key of fixture has a field in v.
+func unmarshalFixture(t *testing.T, name string, v interface{}) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	assert.Nil(t, err)
+	warnings, err := UnmarshalTolerant(data, v)
+	assert.Nil(t, err)
+	assert.Empty(t, warnings)
+
+	object := map[string]interface{}{}
+	assert.Nil(t, json.Unmarshal(data, &object))
+	fields := structFields(reflect.TypeOf(v).Elem())
+	missing := []string{}
+	for key := range object {
+		// Entity name is implied by type.
+		if _, ok := fields[key]; !ok && key != "entity" {
+			missing = append(missing, key)
+		}
+	}
+	sort.Strings(missing)
+	assert.Empty(t, missing, "fields missing for keys of %s", name)
+}
+
+func TestFixture_Payment(t *testing.T) {
+	payment := &Payment{}
+	unmarshalFixture(t, "payment_card.json", payment)
+	assert.Equal(t, "pay_G8VQzjPLoAvm6D", payment.ID)
+	assert.Equal(t, PaymentStatusCaptured, payment.Status)
+	assert.True(t, payment.Captured)
+	assert.Equal(t, "card_G8VQzuM8QvFq6x", payment.CardID)
+	assert.Equal(t, "064381", payment.AcquirerData.AuthCode)
+	assert.Equal(t, "cust_DitrYCFtCIokBO", payment.CustomerID)
+	assert.Empty(t, payment.Notes)
+
+	payment = &Payment{}
+	unmarshalFixture(t, "payment_upi_failed.json", payment)
+	assert.Equal(t, PaymentStatusFailed, payment.Status)
+	assert.Equal(t, "gaurav.kumar@exampleupi", payment.VPA)
+	assert.Equal(t, "customer", payment.ErrorSource)
+	assert.Equal(t, "payment_authentication", payment.ErrorStep)
+	assert.Equal(t, "payment_cancelled", payment.ErrorReason)
+	assert.Equal(t, "033814379298", payment.AcquirerData.RRN)
+	assert.Equal(t, PaymentUPIFlowCollect, payment.UPI.Flow)
+	assert.Equal(t, "Razorpay Corporate Office", payment.Notes["address"])
+}
+
+func TestFixture_Order(t *testing.T) {
+	order := &Order{}
+	unmarshalFixture(t, "order.json", order)
+	assert.Equal(t, "order_EKwxwAgItmmXdp", order.ID)
+	assert.Equal(t, "offer_JHD834hjbxzhd38d", order.OfferID)
+	assert.True(t, order.PartialPayment)
+	assert.Equal(t, int64(20000), order.FirstPaymentMinAmount)
+}
+
+func TestFixture_Refund(t *testing.T) {
+	refund := &Refund{}
+	unmarshalFixture(t, "refund.json", refund)
+	assert.Equal(t, "pay_FCXKPFtYfPXJPy", refund.PaymentId)
+	assert.Equal(t, "10000000000000", refund.AcquirerData.ARN)
+	assert.Equal(t, RefundSpeedOptimum, refund.SpeedRequested)
+	assert.False(t, refund.IsSpeedFulfilled())
+}
+
+func TestFixture_Customer(t *testing.T) {
+	customer := &Customer{}
+	unmarshalFixture(t, "customer.json", customer)
+	assert.Equal(t, "Gaurav Kumar", customer.Name)
+	assert.Equal(t, "29XAbbA4369J1PA", customer.Gstin)
+	assert.Equal(t, int64(1234567890), customer.CreatedAt)
+}
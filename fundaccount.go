@@ -0,0 +1,184 @@
+package razorpay
+
+// FundAccount is a RazorpayX entity representation of bank account, vpa or
+// card of contact, which payouts are made to.
+type FundAccount struct {
+	Response
+	Entity
+	ContactID   string                  `json:"contact_id"`
+	AccountType FundAccountType         `json:"account_type"`
+	BankAccount *FundAccountBankAccount `json:"bank_account"`
+	VPA         *FundAccountVPA         `json:"vpa"`
+	Card        *FundAccountCard        `json:"card"`
+	BatchID     string                  `json:"batch_id"`
+	Active      bool                    `json:"active"`
+}
+
+// FundAccountType is type of fund account.
+type FundAccountType string
+
+// List of fund account types.
+const (
+	FundAccountTypeBankAccount FundAccountType = "bank_account"
+	FundAccountTypeVPA         FundAccountType = "vpa"
+	FundAccountTypeCard        FundAccountType = "card"
+)
+
+// IsValid returns if type is one of known types.
+func (t FundAccountType) IsValid() bool {
+	switch t {
+	case FundAccountTypeBankAccount, FundAccountTypeVPA, FundAccountTypeCard:
+		return true
+	}
+	return false
+}
+
+// Ptr returns pointer to type value.
+func (t FundAccountType) Ptr() *FundAccountType {
+	return &t
+}
+
+// FundAccountBankAccount is bank account of fund account.
+type FundAccountBankAccount struct {
+	Name          string `json:"name"`
+	IFSC          string `json:"ifsc"`
+	BankName      string `json:"bank_name"`
+	AccountNumber string `json:"account_number"`
+}
+
+// FundAccountVPA is vpa of fund account.
+type FundAccountVPA struct {
+	Username string `json:"username"`
+	Handle   string `json:"handle"`
+	Address  string `json:"address"`
+}
+
+// FundAccountCard is card of fund account.
+type FundAccountCard struct {
+	Name          string   `json:"name"`
+	Last4         string   `json:"last4"`
+	Network       string   `json:"network"`
+	Type          CardType `json:"type"`
+	Issuer        string   `json:"issuer"`
+	International bool     `json:"international"`
+}
+
+// FundAccountList is collection of fund accounts.
+type FundAccountList struct {
+	Response
+	EntityList
+	FundAccounts []*FundAccount `json:"items"`
+}
+
+// FundAccountParams is list of params that can be used when creating fund
+// account. Only one of BankAccount, VPA and Card is set, as per AccountType.
+type FundAccountParams struct {
+	Params
+	ContactID   *string                       `json:"contact_id,omitempty"`
+	AccountType *FundAccountType              `json:"account_type,omitempty"`
+	BankAccount *FundAccountBankAccountParams `json:"bank_account,omitempty"`
+	VPA         *FundAccountVPAParams         `json:"vpa,omitempty"`
+	Card        *FundAccountCardParams        `json:"card,omitempty"`
+}
+
+// FundAccountBankAccountParams is list of params of bank account.
+type FundAccountBankAccountParams struct {
+	Name          *string `json:"name,omitempty"`
+	IFSC          *string `json:"ifsc,omitempty"`
+	AccountNumber *string `json:"account_number,omitempty"`
+}
+
+// FundAccountVPAParams is list of params of vpa.
+type FundAccountVPAParams struct {
+	Address *string `json:"address,omitempty"`
+}
+
+// FundAccountCardParams is list of params of card.
+type FundAccountCardParams struct {
+	Name   *string `json:"name,omitempty"`
+	Number *string `json:"number,omitempty"`
+}
+
+// FundAccountActiveParams is list of params that can be used when activating
+// or deactivating fund account.
+type FundAccountActiveParams struct {
+	Params
+	Active *bool `json:"active,omitempty"`
+}
+
+// FundAccountListParams is list params that can be used when listing fund
+// accounts.
+type FundAccountListParams struct {
+	ListParams
+	ContactID   *string          `url:"contact_id,omitempty"`
+	AccountType *FundAccountType `url:"account_type,omitempty"`
+}
+
+// FundAccountValidation is a RazorpayX entity representation of validating
+// fund account, by depositing a small amount into it.
+type FundAccountValidation struct {
+	Response
+	Entity
+	FundAccount *FundAccount                  `json:"fund_account"`
+	Status      FundAccountValidationStatus   `json:"status"`
+	Amount      int64                         `json:"amount"`
+	Currency    Currency                      `json:"currency"`
+	Notes       Notes                         `json:"notes"`
+	Results     *FundAccountValidationResults `json:"results"`
+	UTR         string                        `json:"utr"`
+}
+
+// FundAccountValidationStatus is status of fund account validation.
+type FundAccountValidationStatus string
+
+// List of fund account validation statuses.
+const (
+	FundAccountValidationStatusCreated   FundAccountValidationStatus = "created"
+	FundAccountValidationStatusCompleted FundAccountValidationStatus = "completed"
+	FundAccountValidationStatusFailed    FundAccountValidationStatus = "failed"
+)
+
+// FundAccountValidationResults is result of fund account validation, set once
+// it is completed.
+type FundAccountValidationResults struct {
+	// AccountStatus is active or invalid.
+	AccountStatus string `json:"account_status"`
+	// RegisteredName is name of account holder as registered with bank.
+	RegisteredName string `json:"registered_name"`
+}
+
+// IsActive returns if fund account is validated to be active.
+func (v *FundAccountValidation) IsActive() bool {
+	return v.Status == FundAccountValidationStatusCompleted && v.Results != nil && v.Results.AccountStatus == "active"
+}
+
+// FundAccountValidationList is collection of fund account validations.
+type FundAccountValidationList struct {
+	Response
+	EntityList
+	FundAccountValidations []*FundAccountValidation `json:"items"`
+}
+
+// FundAccountValidationParams is list of params that can be used when
+// validating fund account.
+type FundAccountValidationParams struct {
+	Params
+	// AccountNumber is RazorpayX account number, which deposit is made from.
+	AccountNumber *string                          `json:"account_number,omitempty"`
+	FundAccount   *FundAccountValidationFundParams `json:"fund_account,omitempty"`
+	Amount        *int64                           `json:"amount,omitempty"`
+	Currency      *Currency                        `json:"currency,omitempty"`
+	Notes         Notes                            `json:"notes,omitempty"`
+}
+
+// FundAccountValidationFundParams is fund account to be validated.
+type FundAccountValidationFundParams struct {
+	ID *string `json:"id,omitempty"`
+}
+
+// FundAccountValidationListParams is list params that can be used when
+// listing fund account validations.
+type FundAccountValidationListParams struct {
+	ListParams
+	AccountNumber *string `url:"account_number,omitempty"`
+}
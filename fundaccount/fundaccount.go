@@ -0,0 +1,139 @@
+package fundaccount
+
+import (
+	"context"
+	"net/http"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+)
+
+// Client is used to access /fund_accounts apis.
+type Client struct {
+	*razorpay.Client
+}
+
+// Create creates new fund account of contact.
+func (c *Client) Create(ctx context.Context, params *razorpay.FundAccountParams) (*razorpay.FundAccount, error) {
+	fundAccount := &razorpay.FundAccount{}
+	if err := c.ValidateParams(params); err != nil {
+		return fundAccount, err
+	}
+	err := c.Call(ctx, http.MethodPost, "/fund_accounts", params, fundAccount)
+	return fundAccount, err
+}
+
+// Get returns fund account for id.
+func (c *Client) Get(ctx context.Context, id string) (*razorpay.FundAccount, error) {
+	fundAccount := &razorpay.FundAccount{}
+	err := c.Call(ctx, http.MethodGet, "/fund_accounts/"+id, nil, fundAccount)
+	return fundAccount, err
+}
+
+// List returns list of fund accounts for params.
+func (c *Client) List(ctx context.Context, params *razorpay.FundAccountListParams) (*razorpay.FundAccountList, error) {
+	if params == nil {
+		params = &razorpay.FundAccountListParams{}
+	}
+
+	fundAccountList := &razorpay.FundAccountList{}
+	err := c.Call(ctx, http.MethodGet, "/fund_accounts", params, fundAccountList)
+	return fundAccountList, err
+}
+
+// Activate activates fund account.
+func (c *Client) Activate(ctx context.Context, id string) (*razorpay.FundAccount, error) {
+	return c.setActive(ctx, id, true)
+}
+
+// Deactivate deactivates fund account, after which payouts can not be made to
+// it.
+func (c *Client) Deactivate(ctx context.Context, id string) (*razorpay.FundAccount, error) {
+	return c.setActive(ctx, id, false)
+}
+
+func (c *Client) setActive(ctx context.Context, id string, active bool) (*razorpay.FundAccount, error) {
+	params := &razorpay.FundAccountActiveParams{Active: razorpay.Bool(active)}
+	fundAccount := &razorpay.FundAccount{}
+	err := c.Call(ctx, http.MethodPatch, "/fund_accounts/"+id, params, fundAccount)
+	return fundAccount, err
+}
+
+// CreateValidation validates fund account by depositing a small amount into
+// it. Validation completes asynchronously, see GetValidation.
+func (c *Client) CreateValidation(ctx context.Context, params *razorpay.FundAccountValidationParams) (*razorpay.FundAccountValidation, error) {
+	validation := &razorpay.FundAccountValidation{}
+	if err := c.ValidateParams(params); err != nil {
+		return validation, err
+	}
+	err := c.Call(ctx, http.MethodPost, "/fund_accounts/validations", params, validation)
+	return validation, err
+}
+
+// GetValidation returns fund account validation for id.
+func (c *Client) GetValidation(ctx context.Context, id string) (*razorpay.FundAccountValidation, error) {
+	validation := &razorpay.FundAccountValidation{}
+	err := c.Call(ctx, http.MethodGet, "/fund_accounts/validations/"+id, nil, validation)
+	return validation, err
+}
+
+// ListValidations returns list of fund account validations for params.
+func (c *Client) ListValidations(ctx context.Context, params *razorpay.FundAccountValidationListParams) (*razorpay.FundAccountValidationList, error) {
+	if params == nil {
+		params = &razorpay.FundAccountValidationListParams{}
+	}
+
+	validationList := &razorpay.FundAccountValidationList{}
+	err := c.Call(ctx, http.MethodGet, "/fund_accounts/validations", params, validationList)
+	return validationList, err
+}
+
+// Create creates new fund account of contact.
+func Create(ctx context.Context, params *razorpay.FundAccountParams) (*razorpay.FundAccount, error) {
+	return getDefaultClient().Create(ctx, params)
+}
+
+// Get returns fund account for id.
+func Get(ctx context.Context, id string) (*razorpay.FundAccount, error) {
+	return getDefaultClient().Get(ctx, id)
+}
+
+// List returns list of fund accounts for params.
+func List(ctx context.Context, params *razorpay.FundAccountListParams) (*razorpay.FundAccountList, error) {
+	return getDefaultClient().List(ctx, params)
+}
+
+// Activate activates fund account.
+func Activate(ctx context.Context, id string) (*razorpay.FundAccount, error) {
+	return getDefaultClient().Activate(ctx, id)
+}
+
+// Deactivate deactivates fund account, after which payouts can not be made to
+// it.
+func Deactivate(ctx context.Context, id string) (*razorpay.FundAccount, error) {
+	return getDefaultClient().Deactivate(ctx, id)
+}
+
+// CreateValidation validates fund account by depositing a small amount into
+// it.
+func CreateValidation(ctx context.Context, params *razorpay.FundAccountValidationParams) (*razorpay.FundAccountValidation, error) {
+	return getDefaultClient().CreateValidation(ctx, params)
+}
+
+// GetValidation returns fund account validation for id.
+func GetValidation(ctx context.Context, id string) (*razorpay.FundAccountValidation, error) {
+	return getDefaultClient().GetValidation(ctx, id)
+}
+
+// ListValidations returns list of fund account validations for params.
+func ListValidations(ctx context.Context, params *razorpay.FundAccountValidationListParams) (*razorpay.FundAccountValidationList, error) {
+	return getDefaultClient().ListValidations(ctx, params)
+}
+
+// NewClient returns new client.
+func NewClient(apiKey string, apiSecret string, apiBackend razorpay.Backend) *Client {
+	return &Client{razorpay.NewClient(apiKey, apiSecret, apiBackend)}
+}
+
+func getDefaultClient() *Client {
+	return &Client{razorpay.GetDefaultClient()}
+}
@@ -0,0 +1,71 @@
+package fundaccount
+
+import (
+	"context"
+	"testing"
+
+	faker "github.com/bxcodec/faker/v3"
+	razorpay "github.com/jitendra-1217/razorpay-go"
+	"github.com/jitendra-1217/razorpay-go/contact"
+	"github.com/jitendra-1217/razorpay-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	// fundAccountID holds new fund account id created in Create test.
+	fundAccountID string
+	// accountNumber holds RazorpayX account number used in validation test.
+	accountNumber = "2323230041626905"
+)
+
+func TestClient_Create(t *testing.T) {
+	c, err := contact.Create(context.Background(), &razorpay.ContactParams{Name: razorpay.String(faker.Name())})
+	assert.Nil(t, err)
+	params := &razorpay.FundAccountParams{
+		ContactID:   &c.ID,
+		AccountType: razorpay.FundAccountTypeBankAccount.Ptr(),
+		BankAccount: &razorpay.FundAccountBankAccountParams{
+			Name:          razorpay.String(c.Name),
+			IFSC:          razorpay.String("HDFC0000053"),
+			AccountNumber: razorpay.String("765432123456789"),
+		},
+	}
+	fundAccount, err := Create(context.Background(), params)
+	// For use in later tests.
+	fundAccountID = fundAccount.ID
+	assert.Nil(t, err)
+	assert.True(t, testutil.IsAnyID(fundAccount.ID))
+	assert.Equal(t, c.ID, fundAccount.ContactID)
+	assert.Equal(t, razorpay.FundAccountTypeBankAccount, fundAccount.AccountType)
+	if assert.NotNil(t, fundAccount.BankAccount) {
+		assert.Equal(t, "HDFC0000053", fundAccount.BankAccount.IFSC)
+	}
+}
+
+func TestClient_Get(t *testing.T) {
+	fundAccount, err := Get(context.Background(), fundAccountID)
+	assert.Nil(t, err)
+	assert.Equal(t, fundAccountID, fundAccount.ID)
+}
+
+func TestClient_List(t *testing.T) {
+	_, err := List(context.Background(), nil)
+	assert.Nil(t, err)
+}
+
+func TestClient_CreateValidation(t *testing.T) {
+	params := &razorpay.FundAccountValidationParams{
+		AccountNumber: &accountNumber,
+		FundAccount:   &razorpay.FundAccountValidationFundParams{ID: &fundAccountID},
+		Amount:        razorpay.Int64(100),
+		Currency:      razorpay.CurrencyINR.Ptr(),
+	}
+	validation, err := CreateValidation(context.Background(), params)
+	assert.Nil(t, err)
+	assert.True(t, testutil.IsAnyID(validation.ID))
+	validation, err = GetValidation(context.Background(), validation.ID)
+	assert.Nil(t, err)
+	if assert.NotNil(t, validation.FundAccount) {
+		assert.Equal(t, fundAccountID, validation.FundAccount.ID)
+	}
+}
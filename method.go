@@ -0,0 +1,134 @@
+package razorpay
+
+import "sort"
+
+// Methods is payment methods enabled for account, used to render checkout
+// options. Maps are keyed by bank, wallet, card network or provider code.
+type Methods struct {
+	Response
+	Card         bool              `json:"card"`
+	DebitCard    bool              `json:"debit_card"`
+	CreditCard   bool              `json:"credit_card"`
+	PrepaidCard  bool              `json:"prepaid_card"`
+	CardNetworks map[string]bool   `json:"card_networks"`
+	Netbanking   map[string]string `json:"netbanking"`
+	Wallet       map[string]bool   `json:"wallet"`
+	EMI          bool              `json:"emi"`
+	UPI          bool              `json:"upi"`
+	UPIIntent    bool              `json:"upi_intent"`
+	CardlessEMI  map[string]bool   `json:"cardless_emi"`
+	Paylater     map[string]bool   `json:"paylater"`
+	EMIOptions   EMIPlans          `json:"emi_options"`
+}
+
+// Banks returns sorted codes of netbanking banks.
+func (m *Methods) Banks() []string {
+	banks := make([]string, 0, len(m.Netbanking))
+	for bank := range m.Netbanking {
+		banks = append(banks, bank)
+	}
+	sort.Strings(banks)
+	return banks
+}
+
+// Wallets returns sorted codes of enabled wallets.
+func (m *Methods) Wallets() []string {
+	return enabledKeys(m.Wallet)
+}
+
+// Networks returns sorted codes of enabled card networks e.g. VISA.
+func (m *Methods) Networks() []string {
+	return enabledKeys(m.CardNetworks)
+}
+
+// CardlessEMIProviders returns sorted codes of enabled cardless emi providers.
+func (m *Methods) CardlessEMIProviders() []string {
+	return enabledKeys(m.CardlessEMI)
+}
+
+// PaylaterProviders returns sorted codes of enabled paylater providers.
+func (m *Methods) PaylaterProviders() []string {
+	return enabledKeys(m.Paylater)
+}
+
+func enabledKeys(m map[string]bool) []string {
+	keys := []string{}
+	for key, enabled := range m {
+		if enabled {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Downtime is a Razorpay entity representation of downtime of payment method
+// or instrument e.g. of a bank or upi handle.
+type Downtime struct {
+	Response
+	Entity
+	Method     PaymentMethod      `json:"method"`
+	Begin      int64              `json:"begin"`
+	End        int64              `json:"end"`
+	Status     DowntimeStatus     `json:"status"`
+	Scheduled  bool               `json:"scheduled"`
+	Severity   DowntimeSeverity   `json:"severity"`
+	Instrument DowntimeInstrument `json:"instrument"`
+	UpdatedAt  int64              `json:"updated_at"`
+}
+
+// IsActive returns if downtime is started and not yet resolved.
+func (d *Downtime) IsActive() bool {
+	return d.Status == DowntimeStatusStarted
+}
+
+// DowntimeInstrument is instrument affected by downtime. Only one of the
+// fields is set, as per method, and value "ALL" means all instruments.
+type DowntimeInstrument struct {
+	Bank      string `json:"bank"`
+	Wallet    string `json:"wallet"`
+	Network   string `json:"network"`
+	Issuer    string `json:"issuer"`
+	VPAHandle string `json:"vpa_handle"`
+	PSP       string `json:"psp"`
+}
+
+// DowntimeStatus is status of downtime.
+type DowntimeStatus string
+
+// List of downtime statuses.
+const (
+	DowntimeStatusScheduled DowntimeStatus = "scheduled"
+	DowntimeStatusStarted   DowntimeStatus = "started"
+	DowntimeStatusResolved  DowntimeStatus = "resolved"
+	DowntimeStatusCancelled DowntimeStatus = "cancelled"
+)
+
+// DowntimeSeverity is severity of downtime.
+type DowntimeSeverity string
+
+// List of downtime severities.
+const (
+	DowntimeSeverityLow    DowntimeSeverity = "low"
+	DowntimeSeverityMedium DowntimeSeverity = "medium"
+	DowntimeSeverityHigh   DowntimeSeverity = "high"
+)
+
+// DowntimeList is collection of downtimes.
+type DowntimeList struct {
+	Response
+	EntityList
+	Downtimes []*Downtime `json:"items"`
+}
+
+// Active returns downtimes which are started and not yet resolved, optionally
+// only of given method.
+func (l *DowntimeList) Active(method *PaymentMethod) []*Downtime {
+	downtimes := []*Downtime{}
+	for _, downtime := range l.Downtimes {
+		if downtime.IsActive() && (method == nil || downtime.Method == *method) {
+			downtimes = append(downtimes, downtime)
+		}
+	}
+	return downtimes
+}
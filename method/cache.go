@@ -0,0 +1,152 @@
+package method
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+)
+
+// defaultFetchTimeout is timeout of fetch from remote, when not set on cache.
+const defaultFetchTimeout = 30 * time.Second
+
+// Cache caches enabled methods and downtimes for TTL, so that rendering
+// checkout does not hit remote each time. It is safe for concurrent use, and
+// concurrent callers share a single fetch from remote.
+type Cache struct {
+	Client *Client
+	TTL    time.Duration
+	// RetryAfter is wait before fetching again when fetch fails, during which
+	// cached value, or else error of fetch, is returned. Defaults to TTL.
+	RetryAfter time.Duration
+	// FetchTimeout is timeout of fetch from remote, which is not bound to
+	// context of any caller as it is shared. Defaults to 30 seconds.
+	FetchTimeout time.Duration
+
+	mu        sync.Mutex
+	methods   cacheEntry
+	downtimes cacheEntry
+}
+
+// cacheEntry is a cached value, or error of failed fetch when there is none,
+// along with fetch in flight, if any.
+type cacheEntry struct {
+	value     interface{}
+	err       error
+	expiresAt time.Time
+	call      *cacheCall
+}
+
+// cacheCall is a fetch from remote, shared by callers waiting for it.
+type cacheCall struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// NewCache returns new cache. Default client is used if client is nil.
+func NewCache(client *Client, ttl time.Duration) *Cache {
+	if client == nil {
+		client = getDefaultClient()
+	}
+	return &Cache{Client: client, TTL: ttl}
+}
+
+// Methods returns cached enabled methods, fetching from remote on expiry.
+func (c *Cache) Methods(ctx context.Context) (*razorpay.Methods, error) {
+	value, err := c.get(ctx, &c.methods, func(ctx context.Context) (interface{}, error) {
+		return c.Client.Get(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*razorpay.Methods), nil
+}
+
+// Downtimes returns cached downtimes, fetching from remote on expiry.
+func (c *Cache) Downtimes(ctx context.Context) (*razorpay.DowntimeList, error) {
+	value, err := c.get(ctx, &c.downtimes, func(ctx context.Context) (interface{}, error) {
+		return c.Client.Downtimes(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*razorpay.DowntimeList), nil
+}
+
+// Invalidate clears cache, so that next calls fetch from remote.
+func (c *Cache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.methods.value, c.methods.err = nil, nil
+	c.downtimes.value, c.downtimes.err = nil, nil
+}
+
+// get returns value of entry, fetching it on expiry. Only one fetch is in
+// flight per entry, and it runs without holding lock and detached from context
+// of callers, so that a caller giving up does not fail others. While fetch is
+// in flight, other callers get expired value if any, or else wait for it.
+func (c *Cache) get(ctx context.Context, e *cacheEntry, fetch func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	if (e.value != nil || e.err != nil) && time.Now().Before(e.expiresAt) {
+		value, err := e.value, e.err
+		c.mu.Unlock()
+		return value, err
+	}
+	call := e.call
+	if call != nil && e.value != nil {
+		value := e.value
+		c.mu.Unlock()
+		return value, nil
+	}
+	if call == nil {
+		call = &cacheCall{done: make(chan struct{})}
+		e.call = call
+		go c.fetch(e, call, fetch)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.value, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fetch fetches value of entry and completes call with it.
+func (c *Cache) fetch(e *cacheEntry, call *cacheCall, fetch func(ctx context.Context) (interface{}, error)) {
+	timeout := c.FetchTimeout
+	if timeout <= 0 {
+		timeout = defaultFetchTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	value, err := fetch(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e.call = nil
+	retryAfter := c.RetryAfter
+	if retryAfter <= 0 {
+		retryAfter = c.TTL
+	}
+	switch {
+	case err == nil:
+		e.value, e.err, e.expiresAt = value, nil, time.Now().Add(c.TTL)
+	case e.value != nil:
+		// Falls back to cached value when remote fails, and backs off from
+		// fetching again till RetryAfter.
+		value, err = e.value, nil
+		e.expiresAt = time.Now().Add(retryAfter)
+	default:
+		// Backs off from fetching again till RetryAfter, returning error
+		// meanwhile.
+		value = nil
+		e.err, e.expiresAt = err, time.Now().Add(retryAfter)
+	}
+	call.value, call.err = value, err
+	close(call.done)
+}
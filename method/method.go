@@ -0,0 +1,74 @@
+package method
+
+import (
+	"context"
+	"net/http"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+)
+
+// Client is used to access /methods and /payments/downtimes apis.
+type Client struct {
+	*razorpay.Client
+}
+
+// Get returns payment methods enabled for account.
+func (c *Client) Get(ctx context.Context) (*razorpay.Methods, error) {
+	methods := &razorpay.Methods{}
+	err := c.Call(ctx, http.MethodGet, "/methods", nil, methods)
+	return methods, err
+}
+
+// EMIPlans returns emi plans of banks enabled for account.
+func (c *Client) EMIPlans(ctx context.Context) (razorpay.EMIPlans, error) {
+	methods, err := c.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return methods.EMIOptions, nil
+}
+
+// Downtimes returns list of scheduled, ongoing and recently resolved
+// downtimes.
+func (c *Client) Downtimes(ctx context.Context) (*razorpay.DowntimeList, error) {
+	downtimeList := &razorpay.DowntimeList{}
+	err := c.Call(ctx, http.MethodGet, "/payments/downtimes", nil, downtimeList)
+	return downtimeList, err
+}
+
+// GetDowntime returns downtime for id.
+func (c *Client) GetDowntime(ctx context.Context, id string) (*razorpay.Downtime, error) {
+	downtime := &razorpay.Downtime{}
+	err := c.Call(ctx, http.MethodGet, "/payments/downtimes/"+id, nil, downtime)
+	return downtime, err
+}
+
+// Get returns payment methods enabled for account.
+func Get(ctx context.Context) (*razorpay.Methods, error) {
+	return getDefaultClient().Get(ctx)
+}
+
+// EMIPlans returns emi plans of banks enabled for account.
+func EMIPlans(ctx context.Context) (razorpay.EMIPlans, error) {
+	return getDefaultClient().EMIPlans(ctx)
+}
+
+// Downtimes returns list of scheduled, ongoing and recently resolved
+// downtimes.
+func Downtimes(ctx context.Context) (*razorpay.DowntimeList, error) {
+	return getDefaultClient().Downtimes(ctx)
+}
+
+// GetDowntime returns downtime for id.
+func GetDowntime(ctx context.Context, id string) (*razorpay.Downtime, error) {
+	return getDefaultClient().GetDowntime(ctx, id)
+}
+
+// NewClient returns new client.
+func NewClient(apiKey string, apiSecret string, apiBackend razorpay.Backend) *Client {
+	return &Client{razorpay.NewClient(apiKey, apiSecret, apiBackend)}
+}
+
+func getDefaultClient() *Client {
+	return &Client{razorpay.GetDefaultClient()}
+}
@@ -0,0 +1,148 @@
+package method
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+	"github.com/jitendra-1217/razorpay-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Get(t *testing.T) {
+	methods, err := Get(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, methods.Card)
+	assert.NotEmpty(t, methods.Banks())
+}
+
+func TestClient_Downtimes(t *testing.T) {
+	_, err := Downtimes(context.Background())
+	assert.Nil(t, err)
+}
+
+func TestCache_Methods(t *testing.T) {
+	calls := 0
+	var err error
+	client := NewClient("key", "secret", testutil.BackendFunc(func(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error {
+		calls++
+		v.(*razorpay.Methods).UPI = true
+		return err
+	}))
+	cache := NewCache(client, 20*time.Millisecond)
+
+	// Case: Cached within TTL.
+	for i := 0; i < 3; i++ {
+		methods, err := cache.Methods(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, methods.UPI)
+	}
+	assert.Equal(t, 1, calls)
+
+	// Case: Falls back to cached methods on error after expiry.
+	time.Sleep(25 * time.Millisecond)
+	err = errors.New("failed")
+	methods, cacheErr := cache.Methods(context.Background())
+	assert.Nil(t, cacheErr)
+	assert.True(t, methods.UPI)
+	assert.Equal(t, 2, calls)
+	// Remote is not hit again till retry after.
+	methods, cacheErr = cache.Methods(context.Background())
+	assert.Nil(t, cacheErr)
+	assert.True(t, methods.UPI)
+	assert.Equal(t, 2, calls)
+
+	// Case: Error when nothing is cached, which is returned without hitting
+	// remote till retry after.
+	cache.Invalidate()
+	_, cacheErr = cache.Methods(context.Background())
+	assert.Equal(t, err, cacheErr)
+	_, cacheErr = cache.Methods(context.Background())
+	assert.Equal(t, err, cacheErr)
+	assert.Equal(t, 3, calls)
+	time.Sleep(25 * time.Millisecond)
+	err = nil
+	methods, cacheErr = cache.Methods(context.Background())
+	assert.Nil(t, cacheErr)
+	assert.True(t, methods.UPI)
+	assert.Equal(t, 4, calls)
+}
+
+func TestCache_MethodsConcurrent(t *testing.T) {
+	var calls int32
+	fetching, release := make(chan struct{}), make(chan struct{})
+	client := NewClient("key", "secret", testutil.BackendFunc(func(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error {
+		if atomic.AddInt32(&calls, 1) == 2 {
+			close(fetching)
+			<-release
+		}
+		v.(*razorpay.Methods).UPI = true
+		return nil
+	}))
+	cache := NewCache(client, time.Millisecond)
+	_, err := cache.Methods(context.Background())
+	assert.Nil(t, err)
+	time.Sleep(2 * time.Millisecond)
+
+	// Case: Expired value is returned while fetch is in flight, and callers
+	// share the fetch.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := cache.Methods(context.Background())
+		assert.Nil(t, err)
+	}()
+	<-fetching
+	for i := 0; i < 3; i++ {
+		methods, err := cache.Methods(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, methods.UPI)
+	}
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	// Case: Caller giving up does not fail others waiting for the fetch.
+	cache.Invalidate()
+	atomic.StoreInt32(&calls, 1)
+	fetching, release = make(chan struct{}), make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := cache.Methods(ctx)
+		assert.Equal(t, context.Canceled, err)
+	}()
+	<-fetching
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		methods, err := cache.Methods(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, methods.UPI)
+	}()
+	cancel()
+	time.Sleep(time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	// Case: Callers wait for fetch when nothing is cached.
+	cache.Invalidate()
+	atomic.StoreInt32(&calls, 10)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			methods, err := cache.Methods(context.Background())
+			assert.Nil(t, err)
+			assert.True(t, methods.UPI)
+		}()
+	}
+	wg.Wait()
+}
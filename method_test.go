@@ -0,0 +1,44 @@
+package razorpay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMethods_Unmarshal(t *testing.T) {
+	body := []byte(`{
+		"entity": "methods",
+		"card": true,
+		"card_networks": {"AMEX": 0, "MC": 1, "VISA": 1, "RUPAY": "1"},
+		"netbanking": {"HDFC": "HDFC Bank", "ICIC": "ICICI Bank"},
+		"wallet": {"freecharge": true, "mobikwik": false},
+		"upi": true,
+		"cardless_emi": [],
+		"paylater": {"lazypay": true}
+	}`)
+	methods := &Methods{}
+	warnings, err := UnmarshalTolerant(body, methods)
+	assert.Nil(t, err)
+	assert.Empty(t, warnings)
+	assert.Equal(t, []string{"MC", "RUPAY", "VISA"}, methods.Networks())
+	assert.Equal(t, []string{"HDFC", "ICIC"}, methods.Banks())
+	assert.Equal(t, []string{"freecharge"}, methods.Wallets())
+	assert.Equal(t, []string{}, methods.CardlessEMIProviders())
+	assert.Equal(t, []string{"lazypay"}, methods.PaylaterProviders())
+}
+
+func TestDowntimeList_Active(t *testing.T) {
+	body := []byte(`{"entity": "collection", "count": 2, "items": [
+		{"id": "down_F7LroRQAAFuswd", "method": "upi", "status": "started", "severity": "high", "instrument": {"vpa_handle": "ALL"}},
+		{"id": "down_F7LroRQAAFuswe", "method": "netbanking", "status": "resolved", "severity": "low", "instrument": {"bank": "HDFC"}}
+	]}`)
+	downtimeList := &DowntimeList{}
+	_, err := UnmarshalTolerant(body, downtimeList)
+	assert.Nil(t, err)
+	active := downtimeList.Active(nil)
+	assert.Len(t, active, 1)
+	assert.Equal(t, DowntimeSeverityHigh, active[0].Severity)
+	assert.Equal(t, "ALL", active[0].Instrument.VPAHandle)
+	assert.Len(t, downtimeList.Active(PaymentMethodNetbanking.Ptr()), 0)
+}
@@ -0,0 +1,161 @@
+package razorpay
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Money is amount in minor unit of currency e.g. paise for INR, which is the
+// unit all amounts are sent and received in.
+type Money struct {
+	Amount   int64
+	Currency Currency
+}
+
+// NewMoney returns money for amount in minor unit e.g. paise for INR.
+func NewMoney(amount int64, currency Currency) Money {
+	return Money{amount, currency}
+}
+
+// ParseMoney returns money for decimal value in major unit e.g. "499.50" for
+// INR is 49950 paise. It returns error if value has more digits after decimal
+// separator than the currency allows.
+func ParseMoney(value string, currency Currency) (Money, error) {
+	exponent, ok := currency.Exponent()
+	if !ok {
+		return Money{}, fmt.Errorf("currency %q is not supported", currency)
+	}
+
+	s := strings.TrimSpace(value)
+	negative := false
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+	units, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		units, fraction = s[:i], s[i+1:]
+	}
+	if units == "" && fraction == "" {
+		return Money{}, fmt.Errorf("invalid amount %q", value)
+	}
+	if len(fraction) > exponent {
+		return Money{}, fmt.Errorf("amount %q has more than %d digits after decimal separator for %s", value, exponent, currency)
+	}
+	// Pads fraction to exponent digits, so that units and fraction together
+	// is the amount in minor unit.
+	digits := units + fraction + strings.Repeat("0", exponent-len(fraction))
+
+	var amount int64
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return Money{}, fmt.Errorf("invalid amount %q", value)
+		}
+		if amount > (math.MaxInt64-int64(r-'0'))/10 {
+			return Money{}, fmt.Errorf("amount %q overflows", value)
+		}
+		amount = amount*10 + int64(r-'0')
+	}
+	if negative {
+		amount = -amount
+	}
+
+	return Money{amount, currency}, nil
+}
+
+// MustParseMoney is like ParseMoney but panics on error. It is helpful for
+// constants e.g. in tests.
+func MustParseMoney(value string, currency Currency) Money {
+	m, err := ParseMoney(value, currency)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Decimal returns amount in major unit as decimal string e.g. "499.50".
+func (m Money) Decimal() string {
+	exponent, ok := m.Currency.Exponent()
+	if !ok {
+		exponent = 2
+	}
+
+	sign := ""
+	if m.Amount < 0 {
+		sign = "-"
+	}
+	// Trims sign of formatted value instead of negating amount, to not
+	// overflow for min int64.
+	digits := strings.TrimPrefix(fmt.Sprintf("%d", m.Amount), "-")
+	if exponent == 0 {
+		return sign + digits
+	}
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-exponent] + "." + digits[len(digits)-exponent:]
+}
+
+// String returns amount in major unit with currency e.g. "499.50 INR".
+func (m Money) String() string {
+	return m.Decimal() + " " + string(m.Currency)
+}
+
+// IsZero returns if amount is zero.
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// IsNegative returns if amount is less than zero.
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Add returns sum of money. It returns error if currencies differ or if sum
+// overflows.
+func (m Money) Add(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, fmt.Errorf("currency mismatch: %s and %s", m.Currency, o.Currency)
+	}
+	if (o.Amount > 0 && m.Amount > math.MaxInt64-o.Amount) || (o.Amount < 0 && m.Amount < math.MinInt64-o.Amount) {
+		return Money{}, fmt.Errorf("amount overflows: %s + %s", m, o)
+	}
+	return Money{m.Amount + o.Amount, m.Currency}, nil
+}
+
+// Sub returns difference of money. It returns error if currencies differ or if
+// difference overflows.
+func (m Money) Sub(o Money) (Money, error) {
+	if o.Amount == math.MinInt64 {
+		return Money{}, fmt.Errorf("amount overflows: %s - %s", m, o)
+	}
+	return m.Add(Money{-o.Amount, o.Currency})
+}
+
+// Mul returns money multiplied by n. It returns error if product overflows.
+func (m Money) Mul(n int64) (Money, error) {
+	if m.Amount == 0 || n == 0 {
+		return Money{0, m.Currency}, nil
+	}
+	product := m.Amount * n
+	if product/n != m.Amount || (m.Amount == -1 && n == math.MinInt64) || (n == -1 && m.Amount == math.MinInt64) {
+		return Money{}, fmt.Errorf("amount overflows: %s * %d", m, n)
+	}
+	return Money{product, m.Currency}, nil
+}
+
+// Cmp compares money and returns -1, 0 or +1. It returns error if currencies
+// differ.
+func (m Money) Cmp(o Money) (int, error) {
+	if m.Currency != o.Currency {
+		return 0, fmt.Errorf("currency mismatch: %s and %s", m.Currency, o.Currency)
+	}
+	switch {
+	case m.Amount < o.Amount:
+		return -1, nil
+	case m.Amount > o.Amount:
+		return 1, nil
+	}
+	return 0, nil
+}
@@ -0,0 +1,72 @@
+package razorpay
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMoney(t *testing.T) {
+	cases := []struct {
+		value    string
+		currency Currency
+		amount   int64
+		isErr    bool
+	}{
+		{"499.50", CurrencyINR, 49950, false},
+		{"499.5", CurrencyINR, 49950, false},
+		{"499", CurrencyINR, 49900, false},
+		{".5", CurrencyINR, 50, false},
+		{"-1.25", CurrencyINR, -125, false},
+		{"1000", CurrencyJPY, 1000, false},
+		{"1000.5", CurrencyJPY, 0, true},
+		{"1.234", CurrencyKWD, 1234, false},
+		{"1.234", CurrencyINR, 0, true},
+		{"1,000", CurrencyINR, 0, true},
+		{"", CurrencyINR, 0, true},
+		{"1", Currency("XXX"), 0, true},
+		{"99999999999999999999", CurrencyINR, 0, true},
+	}
+	for _, c := range cases {
+		m, err := ParseMoney(c.value, c.currency)
+		assert.Equal(t, c.isErr, err != nil, c.value)
+		assert.Equal(t, c.amount, m.Amount, c.value)
+	}
+}
+
+func TestMoney_Decimal(t *testing.T) {
+	assert.Equal(t, "499.50", NewMoney(49950, CurrencyINR).Decimal())
+	assert.Equal(t, "0.05", NewMoney(5, CurrencyINR).Decimal())
+	assert.Equal(t, "-0.05", NewMoney(-5, CurrencyINR).Decimal())
+	assert.Equal(t, "1000", NewMoney(1000, CurrencyJPY).Decimal())
+	assert.Equal(t, "1.234 KWD", NewMoney(1234, CurrencyKWD).String())
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	sum, err := MustParseMoney("1.50", CurrencyINR).Add(MustParseMoney("2.25", CurrencyINR))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(375), sum.Amount)
+
+	diff, err := sum.Sub(MustParseMoney("4", CurrencyINR))
+	assert.Nil(t, err)
+	assert.True(t, diff.IsNegative())
+
+	_, err = sum.Add(NewMoney(1, CurrencyUSD))
+	assert.NotNil(t, err)
+
+	_, err = NewMoney(math.MaxInt64, CurrencyINR).Add(NewMoney(1, CurrencyINR))
+	assert.NotNil(t, err)
+
+	_, err = NewMoney(math.MaxInt64/2+1, CurrencyINR).Mul(2)
+	assert.NotNil(t, err)
+
+	product, err := NewMoney(150, CurrencyINR).Mul(3)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(450), product.Amount)
+
+	params := &OrderParams{}
+	params.SetAmount(MustParseMoney("499.50", CurrencyINR))
+	assert.Equal(t, int64(49950), *params.Amount)
+	assert.Equal(t, CurrencyINR, *params.Currency)
+}
@@ -0,0 +1,49 @@
+package razorpay
+
+import "time"
+
+// OAuthToken is a Razorpay OAuth token representation.
+type OAuthToken struct {
+	Response
+	PublicToken       string `json:"public_token"`
+	TokenType         string `json:"token_type"`
+	ExpiresIn         int64  `json:"expires_in"`
+	AccessToken       string `json:"access_token"`
+	RefreshToken      string `json:"refresh_token"`
+	RazorpayAccountID string `json:"razorpay_account_id"`
+
+	// ExpiresAt is unix timestamp computed from ExpiresIn when token is
+	// received. It is not part of remote response.
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+}
+
+// IsExpired returns if token has expired, with leeway to account for clock
+// skew and request latency.
+func (t *OAuthToken) IsExpired(leeway time.Duration) bool {
+	if t.ExpiresAt == 0 {
+		return false
+	}
+	return time.Now().Add(leeway).Unix() >= t.ExpiresAt
+}
+
+// OAuthTokenParams is list of params that can be used when exchanging
+// authorization code or refreshing token.
+type OAuthTokenParams struct {
+	Params
+	ClientID     *string `json:"client_id,omitempty"`
+	ClientSecret *string `json:"client_secret,omitempty"`
+	GrantType    *string `json:"grant_type,omitempty"`
+	RedirectURI  *string `json:"redirect_uri,omitempty"`
+	Code         *string `json:"code,omitempty"`
+	RefreshToken *string `json:"refresh_token,omitempty"`
+	Mode         *string `json:"mode,omitempty"`
+}
+
+// OAuthRevokeParams is list of params that can be used when revoking token.
+type OAuthRevokeParams struct {
+	Params
+	ClientID      *string `json:"client_id,omitempty"`
+	ClientSecret  *string `json:"client_secret,omitempty"`
+	TokenTypeHint *string `json:"token_type_hint,omitempty"`
+	Token         *string `json:"token,omitempty"`
+}
@@ -0,0 +1,222 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+)
+
+const (
+	// defaultAuthHost will be used as auth host by default.
+	defaultAuthHost = "https://auth.razorpay.com"
+
+	// expiryLeeway is duration before actual expiry at which token is
+	// considered expired and so refreshed proactively.
+	expiryLeeway = 1 * time.Minute
+)
+
+var (
+	// AuthHost will be used as auth host. It will default to `defaultAuthHost`.
+	AuthHost string
+)
+
+// Client is used to access OAuth apis of auth host.
+type Client struct {
+	clientID     string
+	clientSecret string
+	authHost     string
+	authBackend  razorpay.Backend
+}
+
+// AuthorizeURLParams is list of params that can be used when building
+// authorization url.
+type AuthorizeURLParams struct {
+	RedirectURI string
+	Scopes      []string
+	State       string
+}
+
+// AuthorizeURL returns url to redirect user to for granting access. On
+// approval, user is redirected to redirect uri with authorization code.
+func (c *Client) AuthorizeURL(params *AuthorizeURLParams) string {
+	queryParams := url.Values{}
+	queryParams.Set("client_id", c.clientID)
+	queryParams.Set("response_type", "code")
+	queryParams.Set("redirect_uri", params.RedirectURI)
+	for _, scope := range params.Scopes {
+		queryParams.Add("scope[]", scope)
+	}
+	queryParams.Set("state", params.State)
+	return c.authHost + "/authorize?" + queryParams.Encode()
+}
+
+// Exchange exchanges authorization code for token.
+func (c *Client) Exchange(ctx context.Context, code string, redirectURI string, mode string) (*razorpay.OAuthToken, error) {
+	params := &razorpay.OAuthTokenParams{
+		ClientID:     razorpay.String(c.clientID),
+		ClientSecret: razorpay.String(c.clientSecret),
+		GrantType:    razorpay.String("authorization_code"),
+		RedirectURI:  razorpay.String(redirectURI),
+		Code:         razorpay.String(code),
+	}
+	if mode != "" {
+		params.Mode = razorpay.String(mode)
+	}
+	return c.token(ctx, params)
+}
+
+// Refresh returns new token for refresh token.
+func (c *Client) Refresh(ctx context.Context, refreshToken string) (*razorpay.OAuthToken, error) {
+	params := &razorpay.OAuthTokenParams{
+		ClientID:     razorpay.String(c.clientID),
+		ClientSecret: razorpay.String(c.clientSecret),
+		GrantType:    razorpay.String("refresh_token"),
+		RefreshToken: razorpay.String(refreshToken),
+	}
+	return c.token(ctx, params)
+}
+
+// Revoke revokes access or refresh token.
+func (c *Client) Revoke(ctx context.Context, token string, tokenTypeHint string) error {
+	params := &razorpay.OAuthRevokeParams{
+		ClientID:      razorpay.String(c.clientID),
+		ClientSecret:  razorpay.String(c.clientSecret),
+		TokenTypeHint: razorpay.String(tokenTypeHint),
+		Token:         razorpay.String(token),
+	}
+	return c.authBackend.Call(ctx, http.MethodPost, "revoke", params, nil)
+}
+
+func (c *Client) token(ctx context.Context, params *razorpay.OAuthTokenParams) (*razorpay.OAuthToken, error) {
+	token := &razorpay.OAuthToken{}
+	err := c.authBackend.Call(ctx, http.MethodPost, "token", params, token)
+	if err != nil {
+		return token, err
+	}
+	if token.ExpiresIn > 0 {
+		token.ExpiresAt = time.Now().Unix() + token.ExpiresIn
+	}
+	return token, nil
+}
+
+// NewClient returns new client. Auth backend, if nil, defaults to api
+// backend against AuthHost.
+func NewClient(clientID string, clientSecret string, authBackend razorpay.Backend) *Client {
+	authHost := defaultAuthHost
+	if AuthHost != "" {
+		authHost = AuthHost
+	}
+	if authBackend == nil {
+		authBackend = &razorpay.APIBackend{Host: authHost, HTTPClient: razorpay.HTTPClient}
+	}
+
+	return &Client{clientID, clientSecret, authHost, authBackend}
+}
+
+// TokenStore persists token across refreshes e.g. in database or cache so
+// that refreshed token is shared by processes.
+type TokenStore interface {
+	Get(ctx context.Context) (*razorpay.OAuthToken, error)
+	Set(ctx context.Context, token *razorpay.OAuthToken) error
+}
+
+// MemoryTokenStore implements TokenStore in memory.
+type MemoryTokenStore struct {
+	mu    sync.RWMutex
+	token *razorpay.OAuthToken
+}
+
+// Get returns stored token.
+func (s *MemoryTokenStore) Get(_ context.Context) (*razorpay.OAuthToken, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.token, nil
+}
+
+// Set stores token.
+func (s *MemoryTokenStore) Set(_ context.Context, token *razorpay.OAuthToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = token
+	return nil
+}
+
+// NewMemoryTokenStore returns new store holding given token.
+func NewMemoryTokenStore(token *razorpay.OAuthToken) *MemoryTokenStore {
+	return &MemoryTokenStore{token: token}
+}
+
+// Authenticator implements razorpay.Authenticator and razorpay.Refresher
+// using bearer access token from store. Token is refreshed when it is about
+// to expire, or when remote responds with 401.
+type Authenticator struct {
+	client *Client
+	store  TokenStore
+	mu     sync.Mutex
+}
+
+// Authenticate sets 'Authorization' header in params.
+func (a *Authenticator) Authenticate(ctx context.Context, params razorpay.RequestParams) error {
+	token, err := a.store.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return fmt.Errorf("oauth token is missing in store")
+	}
+	if token.IsExpired(expiryLeeway) && token.RefreshToken != "" {
+		if err := a.refresh(ctx, token.AccessToken); err != nil {
+			return err
+		}
+		if token, err = a.store.Get(ctx); err != nil {
+			return err
+		}
+	}
+	params.SetHeader("Authorization", "Bearer "+token.AccessToken)
+	return nil
+}
+
+// Refresh refreshes token and stores the new one, unless token in store has
+// changed since params were authenticated with it.
+func (a *Authenticator) Refresh(ctx context.Context, params razorpay.RequestParams) error {
+	accessToken := ""
+	if params != nil {
+		accessToken = strings.TrimPrefix(params.Headers()["Authorization"], "Bearer ")
+	}
+	return a.refresh(ctx, accessToken)
+}
+
+// refresh refreshes token if access token in store is still the stale one.
+// Concurrent callers seeing the same stale token refresh only once, as refresh
+// token is rotated on refresh and so can be used only once.
+func (a *Authenticator) refresh(ctx context.Context, staleAccessToken string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	token, err := a.store.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if token != nil && staleAccessToken != "" && token.AccessToken != staleAccessToken {
+		return nil
+	}
+	if token == nil || token.RefreshToken == "" {
+		return fmt.Errorf("oauth refresh token is missing in store")
+	}
+	newToken, err := a.client.Refresh(ctx, token.RefreshToken)
+	if err != nil {
+		return err
+	}
+	return a.store.Set(ctx, newToken)
+}
+
+// NewAuthenticator returns new authenticator.
+func NewAuthenticator(client *Client, store TokenStore) *Authenticator {
+	return &Authenticator{client: client, store: store}
+}
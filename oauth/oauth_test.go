@@ -0,0 +1,97 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_AuthorizeURL(t *testing.T) {
+	client := NewClient("CLIENT_ID", "CLIENT_SECRET", nil)
+	authorizeURL := client.AuthorizeURL(&AuthorizeURLParams{
+		RedirectURI: "https://example.com/callback",
+		Scopes:      []string{"read_write"},
+		State:       "STATE",
+	})
+	parsedURL, err := url.Parse(authorizeURL)
+	assert.Nil(t, err)
+	assert.Equal(t, "auth.razorpay.com", parsedURL.Host)
+	assert.Equal(t, "/authorize", parsedURL.Path)
+	assert.Equal(t, "CLIENT_ID", parsedURL.Query().Get("client_id"))
+	assert.Equal(t, "code", parsedURL.Query().Get("response_type"))
+	assert.Equal(t, "read_write", parsedURL.Query().Get("scope[]"))
+	assert.Equal(t, "STATE", parsedURL.Query().Get("state"))
+}
+
+func TestAuthenticator(t *testing.T) {
+	// Mocks auth host which issues new access token on refresh, and api host
+	// which accepts only the new access token.
+	var refreshes int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			atomic.AddInt32(&refreshes, 1)
+			body := map[string]string{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, "refresh_token", body["grant_type"])
+			assert.Equal(t, "REFRESH_TOKEN", body["refresh_token"])
+			_, _ = w.Write([]byte(`{"token_type":"Bearer","expires_in":7776000,"access_token":"NEW_ACCESS_TOKEN","refresh_token":"NEW_REFRESH_TOKEN"}`))
+		case "/v1/orders/order_00000000000001":
+			assert.Equal(t, "acc_00000000000001", r.Header.Get("X-Razorpay-Account"))
+			if r.Header.Get("Authorization") != "Bearer NEW_ACCESS_TOKEN" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":{"code":"BAD_REQUEST_ERROR","description":"The access token is invalid"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"id":"order_00000000000001"}`))
+		}
+	}))
+	defer server.Close()
+
+	backend := &razorpay.APIBackend{Host: server.URL, HTTPClient: server.Client()}
+	store := NewMemoryTokenStore(&razorpay.OAuthToken{AccessToken: "ACCESS_TOKEN", RefreshToken: "REFRESH_TOKEN"})
+	authenticator := NewAuthenticator(NewClient("CLIENT_ID", "CLIENT_SECRET", backend), store)
+	client := razorpay.NewClientWithAuthenticator(authenticator, backend).WithAccount("acc_00000000000001")
+
+	order := &razorpay.Order{}
+	err := client.Call(context.Background(), http.MethodGet, "/orders/order_00000000000001", nil, order)
+	assert.Nil(t, err)
+	assert.Equal(t, "order_00000000000001", order.ID)
+
+	token, _ := store.Get(context.Background())
+	assert.Equal(t, "NEW_ACCESS_TOKEN", token.AccessToken)
+	assert.Equal(t, "NEW_REFRESH_TOKEN", token.RefreshToken)
+	assert.True(t, token.ExpiresAt > 0)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&refreshes))
+
+	// Case: Refresh after 401 of request sent with stale token is skipped, as
+	// token is already refreshed.
+	params := &razorpay.Params{}
+	params.SetHeader("Authorization", "Bearer ACCESS_TOKEN")
+	assert.Nil(t, authenticator.Refresh(context.Background(), params))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&refreshes))
+
+	// Case: Concurrent requests with expired token refresh it once.
+	atomic.StoreInt32(&refreshes, 0)
+	_ = store.Set(context.Background(), &razorpay.OAuthToken{AccessToken: "ACCESS_TOKEN", RefreshToken: "REFRESH_TOKEN", ExpiresAt: time.Now().Unix() - 1})
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := client.Call(context.Background(), http.MethodGet, "/orders/order_00000000000001", nil, &razorpay.Order{})
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&refreshes))
+}
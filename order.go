@@ -1,17 +1,164 @@
 package razorpay
 
+import "sort"
+
 // Order is a Razorpay entity representation.
 type Order struct {
 	Response
 	Entity
-	Amount     int64  `json:"amount"`
-	AmountPaid int64  `json:"amount_paid"`
-	AmountDue  int64  `json:"amount_due"`
-	Currency   string `json:"currency"`
-	Receipt    string `json:"receipt"`
-	Status     string `json:"status"`
-	Attempts   int64  `json:"attempts"`
-	Notes      Notes  `json:"notes"`
+	Amount     int64         `json:"amount"`
+	AmountPaid int64         `json:"amount_paid"`
+	AmountDue  int64         `json:"amount_due"`
+	Currency   Currency      `json:"currency"`
+	Receipt    string        `json:"receipt"`
+	Status     OrderStatus   `json:"status"`
+	Attempts   int64         `json:"attempts"`
+	Notes      Notes         `json:"notes"`
+	Method     PaymentMethod `json:"method"`
+	CustomerID string        `json:"customer_id"`
+	Token      *OrderToken   `json:"token"`
+	OfferID    string        `json:"offer_id"`
+
+	// PartialPayment is whether customer can pay order in parts, with first
+	// payment of at least FirstPaymentMinAmount.
+	PartialPayment        bool  `json:"partial_payment"`
+	FirstPaymentMinAmount int64 `json:"first_payment_min_amount"`
+
+	// Payments is set only when expanded, see OrderExpand constants.
+	Payments *PaymentList `json:"payments"`
+}
+
+// List of values that can be set in GetParams.Expand and ListParams.Expand
+// of orders, to receive nested entities.
+const (
+	OrderExpandPayments     = "payments"
+	OrderExpandPaymentsCard = "payments.card"
+)
+
+// OrderToken is token registration details of order created for recurring
+// payments.
+type OrderToken struct {
+	Method          PaymentMethod `json:"method"`
+	RecurringStatus string        `json:"recurring_status"`
+	FailureReason   string        `json:"failure_reason"`
+	Currency        Currency      `json:"currency"`
+	MaxAmount       int64         `json:"max_amount"`
+	AuthType        TokenAuthType `json:"auth_type"`
+	ExpireAt        int64         `json:"expire_at"`
+}
+
+// OrderStatus is status of order. Unknown values are retained as is when
+// unmarshalling, and so IsValid can be used to detect them.
+type OrderStatus string
+
+// List of order statuses.
+const (
+	OrderStatusCreated   OrderStatus = "created"
+	OrderStatusAttempted OrderStatus = "attempted"
+	OrderStatusPaid      OrderStatus = "paid"
+)
+
+// IsValid returns if status is one of known statuses.
+func (s OrderStatus) IsValid() bool {
+	switch s {
+	case OrderStatusCreated, OrderStatusAttempted, OrderStatusPaid:
+		return true
+	}
+	return false
+}
+
+// OrderSummary is payment state of order, computed from order and its
+// payments.
+type OrderSummary struct {
+	Order    *Order
+	Payments []*Payment
+	State    OrderPaymentState
+
+	// Captured is total amount of captured payments, including those refunded
+	// since. Payments refunded without capture e.g. auto-refunded after
+	// remaining authorized are not counted.
+	Captured int64
+	// Refunded is total amount refunded of captured payments.
+	Refunded int64
+	// Outstanding is amount yet to be paid, or zero if paid or overpaid.
+	// Refunds do not add to it, as refunded amount is not to be collected.
+	Outstanding int64
+
+	// LatestCaptured is latest captured payment, if any.
+	LatestCaptured *Payment
+	// Authorized is payments authorized but not captured yet.
+	Authorized []*Payment
+	// Failed is failed payment attempts, having ErrorCode and
+	// ErrorDescription.
+	Failed []*Payment
+}
+
+// OrderPaymentState is payment state of order.
+type OrderPaymentState string
+
+// List of order payment states.
+const (
+	OrderPaymentStateUnpaid        OrderPaymentState = "unpaid"
+	OrderPaymentStatePartiallyPaid OrderPaymentState = "partially_paid"
+	OrderPaymentStatePaid          OrderPaymentState = "paid"
+	OrderPaymentStateOverpaid      OrderPaymentState = "overpaid"
+	OrderPaymentStateRefunded      OrderPaymentState = "refunded"
+)
+
+// NewOrderSummary computes payment state of order from its payments.
+func NewOrderSummary(order *Order, payments []*Payment) *OrderSummary {
+	s := &OrderSummary{Order: order, Payments: payments, Authorized: []*Payment{}, Failed: []*Payment{}}
+
+	// Sorts copy by creation time, so that latest captured and lists are in
+	// order of attempts.
+	sorted := append([]*Payment{}, payments...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt < sorted[j].CreatedAt
+	})
+	for _, payment := range sorted {
+		switch {
+		case payment.Captured || payment.Status == PaymentStatusCaptured:
+			s.Captured += payment.Amount
+			s.Refunded += payment.AmountRefunded
+			s.LatestCaptured = payment
+		case payment.Status == PaymentStatusAuthorized:
+			s.Authorized = append(s.Authorized, payment)
+		case payment.Status == PaymentStatusFailed:
+			s.Failed = append(s.Failed, payment)
+		}
+	}
+
+	// State is of amount captured, unless it is all refunded. Partial refund
+	// is reported by IsPartiallyRefunded instead.
+	switch {
+	case s.Captured == 0:
+		s.State = OrderPaymentStateUnpaid
+	case s.Refunded >= s.Captured:
+		s.State = OrderPaymentStateRefunded
+	case s.Captured < order.Amount:
+		s.State = OrderPaymentStatePartiallyPaid
+	case s.Captured == order.Amount:
+		s.State = OrderPaymentStatePaid
+	default:
+		s.State = OrderPaymentStateOverpaid
+	}
+	if s.Captured < order.Amount {
+		s.Outstanding = order.Amount - s.Captured
+	}
+	return s
+}
+
+// IsPartiallyRefunded returns if part, but not all, of captured amount is
+// refunded.
+func (s *OrderSummary) IsPartiallyRefunded() bool {
+	return s.Refunded > 0 && s.Refunded < s.Captured
+}
+
+// HasMultipleAuthorized returns if more than one payment is authorized and
+// not captured, e.g. when customer retried payment, which needs capture of
+// one and refund or expiry of others.
+func (s *OrderSummary) HasMultipleAuthorized() bool {
+	return len(s.Authorized) > 1
 }
 
 // OrderList is collection of orders.
@@ -24,10 +171,39 @@ type OrderList struct {
 // OrderParams is list of params that can be used when creating or updating order.
 type OrderParams struct {
 	Params
-	Amount   *int64  `json:"amount,omitempty"`
-	Currency *string `json:"currency,omitempty"`
-	Receipt  *string `json:"receipt,omitempty"`
-	Notes    Notes   `json:"notes,omitempty"`
+	Amount         *int64    `json:"amount,omitempty"`
+	Currency       *Currency `json:"currency,omitempty"`
+	Receipt        *string   `json:"receipt,omitempty"`
+	Notes          Notes     `json:"notes,omitempty"`
+	PaymentCapture *bool     `json:"payment_capture,omitempty"`
+
+	PartialPayment        *bool  `json:"partial_payment,omitempty"`
+	FirstPaymentMinAmount *int64 `json:"first_payment_min_amount,omitempty"`
+
+	// Offers are ids of offers applicable on order, and Discount is whether
+	// discount of offer is applied on amount of order.
+	Offers   []string `json:"offers,omitempty"`
+	Discount *bool    `json:"discount,omitempty"`
+
+	// Following are used for registration order i.e. first payment which
+	// registers token for recurring payments.
+	Method     *PaymentMethod    `json:"method,omitempty"`
+	CustomerID *string           `json:"customer_id,omitempty"`
+	Token      *OrderTokenParams `json:"token,omitempty"`
+}
+
+// OrderTokenParams is list of params of token registered with order.
+type OrderTokenParams struct {
+	MaxAmount *int64         `json:"max_amount,omitempty"`
+	ExpireAt  *int64         `json:"expire_at,omitempty"`
+	AuthType  *TokenAuthType `json:"auth_type,omitempty"`
+	Frequency *string        `json:"frequency,omitempty"`
+	Notes     Notes          `json:"notes,omitempty"`
+}
+
+// SetAmount sets amount and currency from money.
+func (p *OrderParams) SetAmount(m Money) {
+	p.Amount, p.Currency = Int64(m.Amount), m.Currency.Ptr()
 }
 
 // OrderListParams is list params that can be used when listing orders.
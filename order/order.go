@@ -3,6 +3,7 @@ package order
 import (
 	"context"
 	"net/http"
+	"sync"
 
 	razorpay "github.com/jitendra-1217/razorpay-go"
 )
@@ -15,6 +16,9 @@ type Client struct {
 // Create creates new order.
 func (c *Client) Create(ctx context.Context, params *razorpay.OrderParams) (*razorpay.Order, error) {
 	order := &razorpay.Order{}
+	if err := c.ValidateParams(params); err != nil {
+		return order, err
+	}
 	err := c.Call(ctx, http.MethodPost, "/orders", params, order)
 	return order, err
 }
@@ -55,6 +59,34 @@ func (c *Client) Payments(ctx context.Context, orderID string) (*razorpay.Paymen
 	return paymentList, err
 }
 
+// Summary returns payment state of order, fetching order and its payments
+// concurrently.
+func (c *Client) Summary(ctx context.Context, id string) (*razorpay.OrderSummary, error) {
+	var order *razorpay.Order
+	var paymentList *razorpay.PaymentList
+	var orderErr, paymentsErr error
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		order, orderErr = c.Get(ctx, id, nil)
+	}()
+	go func() {
+		defer wg.Done()
+		paymentList, paymentsErr = c.Payments(ctx, id)
+	}()
+	wg.Wait()
+
+	if orderErr != nil {
+		return nil, orderErr
+	}
+	if paymentsErr != nil {
+		return nil, paymentsErr
+	}
+	return razorpay.NewOrderSummary(order, paymentList.Payments), nil
+}
+
 // Create creates new order.
 func Create(ctx context.Context, params *razorpay.OrderParams) (*razorpay.Order, error) {
 	return getDefaultClient().Create(ctx, params)
@@ -80,6 +112,11 @@ func Payments(ctx context.Context, orderID string) (*razorpay.PaymentList, error
 	return getDefaultClient().Payments(ctx, orderID)
 }
 
+// Summary returns payment state of order.
+func Summary(ctx context.Context, id string) (*razorpay.OrderSummary, error) {
+	return getDefaultClient().Summary(ctx, id)
+}
+
 // NewClient returns new client.
 func NewClient(apiKey string, apiSecret string, apiBackend razorpay.Backend) *Client {
 	return &Client{razorpay.NewClient(apiKey, apiSecret, apiBackend)}
@@ -17,7 +17,7 @@ var (
 func TestClient_Create(t *testing.T) {
 	params := &razorpay.OrderParams{
 		Amount:   razorpay.Int64(123),
-		Currency: razorpay.String("INR"),
+		Currency: razorpay.CurrencyINR.Ptr(),
 	}
 	order, err := Create(context.Background(), params)
 	// For use in later tests.
@@ -25,7 +25,7 @@ func TestClient_Create(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, testutil.IsAnyID(order.ID))
 	assert.Equal(t, int64(123), order.Amount)
-	assert.Equal(t, "INR", order.Currency)
+	assert.Equal(t, razorpay.CurrencyINR, order.Currency)
 }
 
 func TestClient_Update(t *testing.T) {
@@ -55,9 +55,24 @@ func TestClient_List(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestClient_Get_Expand(t *testing.T) {
+	params := &razorpay.GetParams{Expand: []string{razorpay.OrderExpandPayments}}
+	order, err := Get(context.Background(), "order_FtZ56sg2NgG0tX", params)
+	assert.Nil(t, err)
+	assert.Equal(t, "pay_FtZSrSlgxsJKiQ", order.Payments.Payments[0].ID)
+}
+
 func TestClient_Payments(t *testing.T) {
 	paymentList, err := Payments(context.Background(), "order_FtZ56sg2NgG0tX")
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), paymentList.Count)
 	assert.Equal(t, "pay_FtZSrSlgxsJKiQ", paymentList.Payments[0].ID)
 }
+
+func TestClient_Summary(t *testing.T) {
+	summary, err := Summary(context.Background(), "order_FtZ56sg2NgG0tX")
+	assert.Nil(t, err)
+	assert.Equal(t, "order_FtZ56sg2NgG0tX", summary.Order.ID)
+	assert.Equal(t, "pay_FtZSrSlgxsJKiQ", summary.LatestCaptured.ID)
+	assert.True(t, summary.Refunded > 0)
+}
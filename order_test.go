@@ -0,0 +1,74 @@
+package razorpay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOrderSummary(t *testing.T) {
+	order := &Order{Entity: Entity{ID: "order_00000000000001"}, Amount: 1000}
+	payment := func(id string, createdAt int64, status PaymentStatus, amount int64, amountRefunded int64) *Payment {
+		return &Payment{Entity: Entity{ID: id, CreatedAt: createdAt}, Status: status, Amount: amount, AmountRefunded: amountRefunded, Captured: status == PaymentStatusCaptured}
+	}
+
+	// Case: Unpaid with failed attempt and multiple authorized payments.
+	failed := payment("pay_00000000000001", 1, PaymentStatusFailed, 1000, 0)
+	failed.ErrorCode, failed.ErrorDescription = "BAD_REQUEST_ERROR", "Payment failed"
+	payments := []*Payment{
+		payment("pay_00000000000003", 3, PaymentStatusAuthorized, 1000, 0),
+		payment("pay_00000000000002", 2, PaymentStatusAuthorized, 1000, 0),
+		failed,
+	}
+	s := NewOrderSummary(order, payments)
+	assert.Equal(t, OrderPaymentStateUnpaid, s.State)
+	assert.Equal(t, int64(1000), s.Outstanding)
+	assert.True(t, s.HasMultipleAuthorized())
+	assert.Equal(t, "pay_00000000000002", s.Authorized[0].ID)
+	assert.Equal(t, "BAD_REQUEST_ERROR", s.Failed[0].ErrorCode)
+	assert.Nil(t, s.LatestCaptured)
+
+	// Case: Partially paid.
+	s = NewOrderSummary(order, []*Payment{payment("pay_00000000000001", 1, PaymentStatusCaptured, 400, 0)})
+	assert.Equal(t, OrderPaymentStatePartiallyPaid, s.State)
+	assert.Equal(t, int64(600), s.Outstanding)
+
+	// Case: Overpaid, with latest captured payment.
+	s = NewOrderSummary(order, []*Payment{
+		payment("pay_00000000000002", 2, PaymentStatusCaptured, 1000, 0),
+		payment("pay_00000000000001", 1, PaymentStatusCaptured, 1000, 0),
+	})
+	assert.Equal(t, OrderPaymentStateOverpaid, s.State)
+	assert.Equal(t, int64(2000), s.Captured)
+	assert.Equal(t, int64(0), s.Outstanding)
+	assert.Equal(t, "pay_00000000000002", s.LatestCaptured.ID)
+
+	// Case: Retried, with one captured and other auto-refunded uncaptured.
+	s = NewOrderSummary(order, []*Payment{
+		payment("pay_00000000000001", 1, PaymentStatusRefunded, 1000, 1000),
+		payment("pay_00000000000002", 2, PaymentStatusCaptured, 1000, 0),
+	})
+	assert.Equal(t, OrderPaymentStatePaid, s.State)
+	assert.Equal(t, int64(1000), s.Captured)
+	assert.Equal(t, int64(0), s.Refunded)
+	assert.Equal(t, int64(0), s.Outstanding)
+
+	// Case: Paid and partially refunded, and then fully refunded.
+	s = NewOrderSummary(order, []*Payment{payment("pay_00000000000001", 1, PaymentStatusCaptured, 1000, 300)})
+	assert.Equal(t, OrderPaymentStatePaid, s.State)
+	assert.True(t, s.IsPartiallyRefunded())
+	assert.Equal(t, int64(300), s.Refunded)
+	assert.Equal(t, int64(0), s.Outstanding)
+	refunded := payment("pay_00000000000001", 1, PaymentStatusRefunded, 1000, 1000)
+	refunded.Captured = true
+	s = NewOrderSummary(order, []*Payment{refunded})
+	assert.Equal(t, OrderPaymentStateRefunded, s.State)
+	assert.False(t, s.IsPartiallyRefunded())
+	assert.Equal(t, int64(0), s.Outstanding)
+
+	// Case: Partially paid and partially refunded.
+	s = NewOrderSummary(order, []*Payment{payment("pay_00000000000001", 1, PaymentStatusCaptured, 400, 100)})
+	assert.Equal(t, OrderPaymentStatePartiallyPaid, s.State)
+	assert.True(t, s.IsPartiallyRefunded())
+	assert.Equal(t, int64(600), s.Outstanding)
+}
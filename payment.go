@@ -4,33 +4,155 @@ package razorpay
 type Payment struct {
 	Response
 	Entity
-	Amount           int64  `json:"amount"`
-	Currency         string `json:"currency"`
-	Status           string `json:"status"`
-	Method           string `json:"method"`
-	OrderID          string `json:"order_id"`
-	Description      string `json:"description"`
-	AmountRefunded   int64  `json:"amount_refunded"`
-	RefundStatus     string `json:"refund_status"`
-	Email            string `json:"email"`
-	Contact          string `json:"contact"`
-	Notes            Notes  `json:"notes"`
-	Fee              int64  `json:"fee"`
-	Tax              int64  `json:"tax"`
-	ErrorCode        string `json:"error_code"`
-	ErrorDescription string `json:"error_description"`
-}
-
-type Card struct {
-	Response
-	Entity
-	Name          string `json:"name"`
-	Last4         string `json:"last4"`
-	Network       string `json:"network"`
-	Type          string `json:"type"`
-	Issuer        string `json:"issuer"`
-	International bool   `json:"international"`
-	Emi           bool   `json:"emi"`
+	Amount            int64               `json:"amount"`
+	Currency          Currency            `json:"currency"`
+	Status            PaymentStatus       `json:"status"`
+	Method            PaymentMethod       `json:"method"`
+	OrderID           string              `json:"order_id"`
+	InvoiceID         string              `json:"invoice_id"`
+	International     bool                `json:"international"`
+	Description       string              `json:"description"`
+	AmountRefunded    int64               `json:"amount_refunded"`
+	AmountTransferred int64               `json:"amount_transferred"`
+	RefundStatus      string              `json:"refund_status"`
+	Captured          bool                `json:"captured"`
+	Email             string              `json:"email"`
+	Contact           string              `json:"contact"`
+	Notes             Notes               `json:"notes"`
+	Fee               int64               `json:"fee"`
+	Tax               int64               `json:"tax"`
+	ErrorCode         string              `json:"error_code"`
+	ErrorDescription  string              `json:"error_description"`
+	ErrorSource       string              `json:"error_source"`
+	ErrorStep         string              `json:"error_step"`
+	ErrorReason       string              `json:"error_reason"`
+	CustomerID        string              `json:"customer_id"`
+	TokenID           string              `json:"token_id"`
+	CardID            string              `json:"card_id"`
+	Bank              string              `json:"bank"`
+	Wallet            string              `json:"wallet"`
+	VPA               string              `json:"vpa"`
+	AcquirerData      PaymentAcquirerData `json:"acquirer_data"`
+	Recurring         bool                `json:"recurring"`
+	OfferID           string              `json:"offer_id"`
+
+	// Following are set only when expanded, see PaymentExpand constants. UPI
+	// is also set for upi payments.
+	Card   *Card             `json:"card"`
+	EMI    *PaymentEMI       `json:"emi"`
+	Offers *PaymentOfferList `json:"offers"`
+	UPI    *PaymentUPI       `json:"upi"`
+}
+
+// PaymentAcquirerData is reference of payment at bank, as per method.
+type PaymentAcquirerData struct {
+	// AuthCode is authorization code of card payments.
+	AuthCode string `json:"auth_code"`
+	// RRN is retrieval reference number of upi and card payments.
+	RRN string `json:"rrn"`
+	// BankTransactionID is reference of netbanking payments.
+	BankTransactionID string `json:"bank_transaction_id"`
+	// TransactionID is reference of wallet payments.
+	TransactionID                 string `json:"transaction_id"`
+	UPITransactionID              string `json:"upi_transaction_id"`
+	AuthenticationReferenceNumber string `json:"authentication_reference_number"`
+}
+
+// List of values that can be set in GetParams.Expand and ListParams.Expand
+// of payments, to receive nested entities.
+const (
+	PaymentExpandCard   = "card"
+	PaymentExpandEMI    = "emi"
+	PaymentExpandOffers = "offers"
+	PaymentExpandUPI    = "upi"
+)
+
+// PaymentEMI is emi details of payment.
+type PaymentEMI struct {
+	Issuer string   `json:"issuer"`
+	Type   CardType `json:"type"`
+	// Rate is annual rate of interest in basis points e.g. 1300 for 13%.
+	Rate     int64 `json:"rate"`
+	Duration int64 `json:"duration"`
+}
+
+// PaymentUPI is upi details of payment.
+type PaymentUPI struct {
+	PayerAccountType string         `json:"payer_account_type"`
+	VPA              string         `json:"vpa"`
+	Flow             PaymentUPIFlow `json:"flow"`
+}
+
+// PaymentOfferList is collection of offers applied on payment.
+type PaymentOfferList struct {
+	EntityList
+	Offers []*PaymentOffer `json:"items"`
+}
+
+// PaymentOffer is offer applied on payment.
+type PaymentOffer struct {
+	ID string `json:"id"`
+}
+
+// PaymentStatus is status of payment. Unknown values are retained as is when
+// unmarshalling, and so IsValid can be used to detect them.
+type PaymentStatus string
+
+// List of payment statuses.
+const (
+	PaymentStatusCreated    PaymentStatus = "created"
+	PaymentStatusAuthorized PaymentStatus = "authorized"
+	PaymentStatusCaptured   PaymentStatus = "captured"
+	PaymentStatusRefunded   PaymentStatus = "refunded"
+	PaymentStatusFailed     PaymentStatus = "failed"
+)
+
+// IsValid returns if status is one of known statuses.
+func (s PaymentStatus) IsValid() bool {
+	switch s {
+	case PaymentStatusCreated, PaymentStatusAuthorized, PaymentStatusCaptured, PaymentStatusRefunded, PaymentStatusFailed:
+		return true
+	}
+	return false
+}
+
+// IsPending returns if payment is yet to be authorized or failed, e.g. while
+// customer completes authentication.
+func (s PaymentStatus) IsPending() bool {
+	return s == PaymentStatusCreated
+}
+
+// PaymentMethod is method of payment. Unknown values are retained as is when
+// unmarshalling, and so IsValid can be used to detect them.
+type PaymentMethod string
+
+// List of payment methods.
+const (
+	PaymentMethodCard         PaymentMethod = "card"
+	PaymentMethodNetbanking   PaymentMethod = "netbanking"
+	PaymentMethodWallet       PaymentMethod = "wallet"
+	PaymentMethodEMI          PaymentMethod = "emi"
+	PaymentMethodUPI          PaymentMethod = "upi"
+	PaymentMethodCardlessEMI  PaymentMethod = "cardless_emi"
+	PaymentMethodPaylater     PaymentMethod = "paylater"
+	PaymentMethodBankTransfer PaymentMethod = "bank_transfer"
+	PaymentMethodEmandate     PaymentMethod = "emandate"
+	PaymentMethodNach         PaymentMethod = "nach"
+)
+
+// IsValid returns if method is one of known methods.
+func (m PaymentMethod) IsValid() bool {
+	switch m {
+	case PaymentMethodCard, PaymentMethodNetbanking, PaymentMethodWallet, PaymentMethodEMI, PaymentMethodUPI,
+		PaymentMethodCardlessEMI, PaymentMethodPaylater, PaymentMethodBankTransfer, PaymentMethodEmandate, PaymentMethodNach:
+		return true
+	}
+	return false
+}
+
+// Ptr returns pointer to method value.
+func (m PaymentMethod) Ptr() *PaymentMethod {
+	return &m
 }
 
 // PaymentList is collection of payments.
@@ -49,8 +171,169 @@ type PaymentUpdateParams struct {
 // PaymentCaptureParams is list of params that can be used when capturing existing payment.
 type PaymentCaptureParams struct {
 	Params
-	Amount   *int64  `json:"amount,omitempty"`
-	Currency *string `json:"currency,omitempty"`
+	Amount   *int64    `json:"amount,omitempty"`
+	Currency *Currency `json:"currency,omitempty"`
+}
+
+// SetAmount sets amount and currency from money.
+func (p *PaymentCaptureParams) SetAmount(m Money) {
+	p.Amount, p.Currency = Int64(m.Amount), m.Currency.Ptr()
+}
+
+// PaymentRecurringParams is list of params that can be used when creating
+// recurring payment using token of customer.
+type PaymentRecurringParams struct {
+	Params
+	Email       *string   `json:"email,omitempty"`
+	Contact     *string   `json:"contact,omitempty"`
+	Amount      *int64    `json:"amount,omitempty"`
+	Currency    *Currency `json:"currency,omitempty"`
+	OrderID     *string   `json:"order_id,omitempty"`
+	CustomerID  *string   `json:"customer_id,omitempty"`
+	Token       *string   `json:"token,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Notes       Notes     `json:"notes,omitempty"`
+
+	// Recurring is "1" for recurring payment, which is default, or "preferred"
+	// to charge token even if it is not registered for recurring payments.
+	Recurring *string `json:"recurring,omitempty"`
+}
+
+// SetAmount sets amount and currency from money.
+func (p *PaymentRecurringParams) SetAmount(m Money) {
+	p.Amount, p.Currency = Int64(m.Amount), m.Currency.Ptr()
+}
+
+// PaymentRecurringResponse is response of creating recurring payment.
+type PaymentRecurringResponse struct {
+	Response
+	PaymentID string `json:"razorpay_payment_id"`
+	OrderID   string `json:"razorpay_order_id"`
+	Signature string `json:"razorpay_signature"`
+}
+
+// PaymentCreateParams is list of params that can be used when creating payment
+// server to server, without checkout.
+type PaymentCreateParams struct {
+	Params
+	Amount      *int64             `json:"amount,omitempty"`
+	Currency    *Currency          `json:"currency,omitempty"`
+	OrderID     *string            `json:"order_id,omitempty"`
+	Email       *string            `json:"email,omitempty"`
+	Contact     *string            `json:"contact,omitempty"`
+	Method      *PaymentMethod     `json:"method,omitempty"`
+	Card        *PaymentCardParams `json:"card,omitempty"`
+	Bank        *string            `json:"bank,omitempty"`
+	Wallet      *string            `json:"wallet,omitempty"`
+	UPI         *PaymentUPIParams  `json:"upi,omitempty"`
+	CustomerID  *string            `json:"customer_id,omitempty"`
+	Token       *string            `json:"token,omitempty"`
+	Save        *bool              `json:"save,omitempty"`
+	CallbackURL *string            `json:"callback_url,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Notes       Notes              `json:"notes,omitempty"`
+
+	// Following are of customer's browser or device, and are required.
+	IP        *string `json:"ip,omitempty"`
+	Referer   *string `json:"referer,omitempty"`
+	UserAgent *string `json:"user_agent,omitempty"`
+}
+
+// SetAmount sets amount and currency from money.
+func (p *PaymentCreateParams) SetAmount(m Money) {
+	p.Amount, p.Currency = Int64(m.Amount), m.Currency.Ptr()
+}
+
+// PaymentCardParams is list of params of card used for payment.
+type PaymentCardParams struct {
+	Number      *string `json:"number,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	ExpiryMonth *string `json:"expiry_month,omitempty"`
+	ExpiryYear  *string `json:"expiry_year,omitempty"`
+	CVV         *string `json:"cvv,omitempty"`
+}
+
+// PaymentUPIParams is list of params of upi payment.
+type PaymentUPIParams struct {
+	Flow *PaymentUPIFlow `json:"flow,omitempty"`
+	// VPA is required for collect flow.
+	VPA *string `json:"vpa,omitempty"`
+	// ExpiryTime is minutes in which customer should approve collect request.
+	ExpiryTime *int64 `json:"expiry_time,omitempty"`
+}
+
+// PaymentUPIFlow is flow of upi payment.
+type PaymentUPIFlow string
+
+// List of upi flows.
+const (
+	// PaymentUPIFlowCollect sends collect request to vpa of customer.
+	PaymentUPIFlowCollect PaymentUPIFlow = "collect"
+	// PaymentUPIFlowIntent returns intent url which opens upi app of customer.
+	PaymentUPIFlowIntent PaymentUPIFlow = "intent"
+)
+
+// IsValid returns if flow is one of known flows.
+func (f PaymentUPIFlow) IsValid() bool {
+	return f == PaymentUPIFlowCollect || f == PaymentUPIFlowIntent
+}
+
+// Ptr returns pointer to flow value.
+func (f PaymentUPIFlow) Ptr() *PaymentUPIFlow {
+	return &f
+}
+
+// PaymentCreateResponse is response of creating payment server to server, and
+// of submitting or resending otp. Next lists actions to complete payment.
+type PaymentCreateResponse struct {
+	Response
+	PaymentID string               `json:"razorpay_payment_id"`
+	OrderID   string               `json:"razorpay_order_id"`
+	Signature string               `json:"razorpay_signature"`
+	Next      []*PaymentNextAction `json:"next"`
+}
+
+// NextAction returns next action of given type, if any.
+func (r *PaymentCreateResponse) NextAction(action PaymentNextActionType) (*PaymentNextAction, bool) {
+	for _, next := range r.Next {
+		if next.Action == action {
+			return next, true
+		}
+	}
+	return nil, false
+}
+
+// PaymentNextAction is action to be taken to complete payment.
+type PaymentNextAction struct {
+	Action PaymentNextActionType `json:"action"`
+	URL    string                `json:"url"`
+}
+
+// PaymentNextActionType is type of next action.
+type PaymentNextActionType string
+
+// List of next action types.
+const (
+	// PaymentNextActionRedirect is to redirect customer to url for
+	// authentication e.g. 3DS page of card or login page of bank.
+	PaymentNextActionRedirect PaymentNextActionType = "redirect"
+	// PaymentNextActionOTPGenerate is to generate otp, for native otp flow.
+	PaymentNextActionOTPGenerate PaymentNextActionType = "otp_generate"
+	// PaymentNextActionOTPSubmit is to submit otp entered by customer.
+	PaymentNextActionOTPSubmit PaymentNextActionType = "otp_submit"
+	// PaymentNextActionOTPResend is to resend otp to customer.
+	PaymentNextActionOTPResend PaymentNextActionType = "otp_resend"
+	// PaymentNextActionIntent is to open upi app of customer with url.
+	PaymentNextActionIntent PaymentNextActionType = "intent"
+	// PaymentNextActionPoll is to poll status of payment till customer
+	// approves it e.g. upi collect request.
+	PaymentNextActionPoll PaymentNextActionType = "poll"
+)
+
+// PaymentOTPParams is list of params that can be used when submitting otp.
+type PaymentOTPParams struct {
+	Params
+	OTP *string `json:"otp,omitempty"`
 }
 
 // PaymentListParams is list of params that can be used when listing payments.
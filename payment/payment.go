@@ -22,6 +22,9 @@ func (c *Client) Update(ctx context.Context, id string, params *razorpay.Payment
 // Capture captures existing payment.
 func (c *Client) Capture(ctx context.Context, id string, params *razorpay.PaymentCaptureParams) (*razorpay.Payment, error) {
 	payment := &razorpay.Payment{}
+	if err := c.ValidateParams(params); err != nil {
+		return payment, err
+	}
 	err := c.Call(ctx, http.MethodPost, "/payments/"+id+"/capture", params, payment)
 	return payment, err
 }
@@ -58,6 +61,9 @@ func (c *Client) GetCard(ctx context.Context, paymentID string) (*razorpay.Card,
 // CreateRefund creates new refund for the payment.
 func (c *Client) CreateRefund(ctx context.Context, paymentID string, params *razorpay.RefundCreateParams) (*razorpay.Refund, error) {
 	refund := &razorpay.Refund{}
+	if err := c.ValidateParams(params); err != nil {
+		return refund, err
+	}
 	err := c.Call(ctx, http.MethodPost, "/payments/"+paymentID+"/refund", params, refund)
 	return refund, err
 }
@@ -69,6 +75,78 @@ func (c *Client) Refunds(ctx context.Context, paymentID string) (*razorpay.Refun
 	return refundList, err
 }
 
+// Disputes returns list of disputes raised on payment.
+func (c *Client) Disputes(ctx context.Context, paymentID string) (*razorpay.DisputeList, error) {
+	disputeList := &razorpay.DisputeList{}
+	err := c.Call(ctx, http.MethodGet, "/payments/"+paymentID+"/disputes", nil, disputeList)
+	return disputeList, err
+}
+
+// CreateJSON creates payment server to server, without checkout. Response
+// lists next actions to complete payment e.g. redirect customer for 3DS,
+// submit otp, open upi intent, or poll status of upi collect request.
+func (c *Client) CreateJSON(ctx context.Context, params *razorpay.PaymentCreateParams) (*razorpay.PaymentCreateResponse, error) {
+	resp := &razorpay.PaymentCreateResponse{}
+	if err := c.ValidateParams(params); err != nil {
+		return resp, err
+	}
+	err := c.Call(ctx, http.MethodPost, "/payments/create/json", params, resp)
+	return resp, err
+}
+
+// SubmitOTP submits otp entered by customer for payment.
+func (c *Client) SubmitOTP(ctx context.Context, id string, otp string) (*razorpay.PaymentCreateResponse, error) {
+	resp := &razorpay.PaymentCreateResponse{}
+	params := &razorpay.PaymentOTPParams{OTP: &otp}
+	err := c.Call(ctx, http.MethodPost, "/payments/"+id+"/otp/submit", params, resp)
+	return resp, err
+}
+
+// ResendOTP resends otp to customer for payment.
+func (c *Client) ResendOTP(ctx context.Context, id string) (*razorpay.PaymentCreateResponse, error) {
+	resp := &razorpay.PaymentCreateResponse{}
+	err := c.Call(ctx, http.MethodPost, "/payments/"+id+"/otp/resend", nil, resp)
+	return resp, err
+}
+
+// Poll polls payment until it is no longer pending i.e. is authorized,
+// captured or failed, waiting between attempts as per backoff. It is used
+// e.g. while customer approves upi collect request, and so context should
+// have deadline. Temporary errors are retried, and last fetched payment is
+// returned along with error.
+func (c *Client) Poll(ctx context.Context, id string, backoff *razorpay.Backoff) (*razorpay.Payment, error) {
+	payment := &razorpay.Payment{}
+	err := razorpay.Poll(ctx, backoff, func(ctx context.Context) (bool, error) {
+		fetched, err := c.Get(ctx, id, nil)
+		if err != nil {
+			return false, err
+		}
+		payment = fetched
+		return !payment.Status.IsPending(), nil
+	})
+	return payment, err
+}
+
+// CreateRecurring creates recurring payment using token of customer, which
+// is registered with first payment of registration order.
+func (c *Client) CreateRecurring(ctx context.Context, params *razorpay.PaymentRecurringParams) (*razorpay.PaymentRecurringResponse, error) {
+	if params == nil {
+		params = &razorpay.PaymentRecurringParams{}
+	}
+
+	resp := &razorpay.PaymentRecurringResponse{}
+	if err := c.ValidateParams(params); err != nil {
+		return resp, err
+	}
+	if params.Recurring == nil {
+		clone := *params
+		clone.Recurring = razorpay.String("1")
+		params = &clone
+	}
+	err := c.Call(ctx, http.MethodPost, "/payments/create/recurring", params, resp)
+	return resp, err
+}
+
 // Update updates existing payment.
 func Update(ctx context.Context, id string, params *razorpay.PaymentUpdateParams) (*razorpay.Payment, error) {
 	return getDefaultClient().Update(ctx, id, params)
@@ -104,6 +182,36 @@ func Refunds(ctx context.Context, paymentID string) (*razorpay.RefundList, error
 	return getDefaultClient().Refunds(ctx, paymentID)
 }
 
+// Disputes returns list of disputes raised on payment.
+func Disputes(ctx context.Context, paymentID string) (*razorpay.DisputeList, error) {
+	return getDefaultClient().Disputes(ctx, paymentID)
+}
+
+// CreateJSON creates payment server to server, without checkout.
+func CreateJSON(ctx context.Context, params *razorpay.PaymentCreateParams) (*razorpay.PaymentCreateResponse, error) {
+	return getDefaultClient().CreateJSON(ctx, params)
+}
+
+// SubmitOTP submits otp entered by customer for payment.
+func SubmitOTP(ctx context.Context, id string, otp string) (*razorpay.PaymentCreateResponse, error) {
+	return getDefaultClient().SubmitOTP(ctx, id, otp)
+}
+
+// ResendOTP resends otp to customer for payment.
+func ResendOTP(ctx context.Context, id string) (*razorpay.PaymentCreateResponse, error) {
+	return getDefaultClient().ResendOTP(ctx, id)
+}
+
+// Poll polls payment until it is no longer pending.
+func Poll(ctx context.Context, id string, backoff *razorpay.Backoff) (*razorpay.Payment, error) {
+	return getDefaultClient().Poll(ctx, id, backoff)
+}
+
+// CreateRecurring creates recurring payment using token of customer.
+func CreateRecurring(ctx context.Context, params *razorpay.PaymentRecurringParams) (*razorpay.PaymentRecurringResponse, error) {
+	return getDefaultClient().CreateRecurring(ctx, params)
+}
+
 // NewClient returns new client.
 func NewClient(apiKey string, apiSecret string, apiBackend razorpay.Backend) *Client {
 	return &Client{razorpay.NewClient(apiKey, apiSecret, apiBackend)}
@@ -2,12 +2,15 @@ package payment
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"net/http"
 	"testing"
+	"time"
 
 	faker "github.com/bxcodec/faker/v3"
 	razorpay "github.com/jitendra-1217/razorpay-go"
-	_ "github.com/jitendra-1217/razorpay-go/testutil"
+	"github.com/jitendra-1217/razorpay-go/testutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -35,7 +38,7 @@ func TestClient_Capture(t *testing.T) {
 	// Actually there is no way to create a new payment to capture either. So this is it.
 	params := &razorpay.PaymentCaptureParams{
 		Amount:   razorpay.Int64(123),
-		Currency: razorpay.String("INR"),
+		Currency: razorpay.CurrencyINR.Ptr(),
 	}
 	_, err := Capture(context.Background(), paymentID, params)
 	assert.NotNil(t, err)
@@ -51,6 +54,13 @@ func TestClient_Get(t *testing.T) {
 	assert.Equal(t, paymentID, payment.ID)
 }
 
+func TestClient_Get_Expand(t *testing.T) {
+	params := &razorpay.GetParams{Expand: []string{razorpay.PaymentExpandCard}}
+	payment, err := Get(context.Background(), paymentID, params)
+	assert.Nil(t, err)
+	assert.Equal(t, "1111", payment.Card.Last4)
+}
+
 func TestClient_List(t *testing.T) {
 	_, err := List(context.Background(), nil)
 	assert.Nil(t, err)
@@ -62,7 +72,7 @@ func TestClient_GetCard(t *testing.T) {
 	assert.Equal(t, "Kalidasa B", card.Name)
 	assert.Equal(t, "1111", card.Last4)
 	assert.Equal(t, "Visa", card.Network)
-	assert.Equal(t, "debit", card.Type)
+	assert.Equal(t, razorpay.CardTypeDebit, card.Type)
 }
 
 func TestClient_CreateRefund(t *testing.T) {
@@ -80,9 +90,56 @@ func TestClient_CreateRefund(t *testing.T) {
 	assert.Equal(t, "The total refund amount is greater than the refund payment amount", razorpayErr.Description)
 }
 
+func TestClient_Disputes(t *testing.T) {
+	disputeList, err := Disputes(context.Background(), paymentID)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), disputeList.Count)
+}
+
 func TestClient_Refunds(t *testing.T) {
 	refundList, err := Refunds(context.Background(), paymentID)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), refundList.Count)
 	assert.Equal(t, "rfnd_FtakiH6gO6Wehb", refundList.Refunds[0].ID)
 }
+
+func TestClient_Poll(t *testing.T) {
+	calls := 0
+	client := NewClient("key", "secret", testutil.BackendFunc(func(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error {
+		calls++
+		assert.Equal(t, "v1/payments/pay_00000000000001", path)
+		payment := v.(*razorpay.Payment)
+		payment.ID = "pay_00000000000001"
+		payment.Status = razorpay.PaymentStatusCreated
+		switch calls {
+		case 2:
+			return &razorpay.Error{StatusCode: http.StatusBadGateway, Code: "SERVER_ERROR"}
+		case 4:
+			payment.Status = razorpay.PaymentStatusAuthorized
+		}
+		return nil
+	}))
+	backoff := &razorpay.Backoff{Initial: time.Millisecond, Multiplier: 1}
+	payment, err := client.Poll(context.Background(), "pay_00000000000001", backoff)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, calls)
+	assert.Equal(t, razorpay.PaymentStatusAuthorized, payment.Status)
+
+	// Case: Decode error is returned.
+	calls = 0
+	backend := testutil.BackendFunc(func(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error {
+		calls++
+		return &json.SyntaxError{}
+	})
+	client = NewClient("key", "secret", backend)
+	_, err = client.Poll(context.Background(), "pay_00000000000001", backoff)
+	assert.Equal(t, &json.SyntaxError{}, err)
+	assert.Equal(t, 1, calls)
+
+	// Case: Credentials provider error is returned.
+	provider := &razorpay.EnvCredentialsProvider{APIKeyEnv: "TEST_UNSET_KEY_ID", APISecretEnv: "TEST_UNSET_KEY_SECRET"}
+	client = &Client{razorpay.NewClientWithCredentialsProvider(provider, backend)}
+	_, err = client.Poll(context.Background(), "pay_00000000000001", backoff)
+	assert.EqualError(t, err, "TEST_UNSET_KEY_ID or TEST_UNSET_KEY_SECRET env is not set")
+	assert.Equal(t, 1, calls)
+}
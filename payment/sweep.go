@@ -0,0 +1,204 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+	"github.com/jitendra-1217/razorpay-go/order"
+)
+
+// sweepPageSize is number of payments listed per page, maximum allowed.
+const sweepPageSize = 100
+
+// SweepStatus is outcome of sweeping an authorized payment.
+type SweepStatus string
+
+// List of sweep statuses.
+const (
+	// SweepStatusCaptured is payment captured by sweep.
+	SweepStatusCaptured SweepStatus = "captured"
+	// SweepStatusAlreadyCaptured is payment captured meanwhile, e.g. by a
+	// retried capture call.
+	SweepStatusAlreadyCaptured SweepStatus = "already_captured"
+	// SweepStatusCapturable is payment which would be captured, in dry run.
+	SweepStatusCapturable SweepStatus = "capturable"
+	// SweepStatusSkipped is payment not captured e.g. as its order is already
+	// paid, or payment exceeds amount due of order.
+	SweepStatusSkipped SweepStatus = "skipped"
+	// SweepStatusFailed is payment which failed to capture.
+	SweepStatusFailed SweepStatus = "failed"
+)
+
+// SweepParams is list of params that can be used when sweeping authorized
+// payments.
+type SweepParams struct {
+	// From and To is window in which payments were created, and are required
+	// so that whole history of account is not swept by mistake.
+	From time.Time
+	To   time.Time
+	// RequireOrder skips payments not made against an order.
+	RequireOrder bool
+	// DryRun reports payments which would be captured, without capturing.
+	DryRun bool
+}
+
+// SweepResult is outcome of sweeping an authorized payment.
+type SweepResult struct {
+	PaymentID string            `json:"payment_id"`
+	OrderID   string            `json:"order_id,omitempty"`
+	Amount    int64             `json:"amount"`
+	Currency  razorpay.Currency `json:"currency"`
+	Status    SweepStatus       `json:"status"`
+	Reason    string            `json:"reason,omitempty"`
+	ErrorCode string            `json:"error_code,omitempty"`
+	Temporary bool              `json:"temporary,omitempty"`
+}
+
+// SweepReport is outcome of sweeping authorized payments.
+type SweepReport struct {
+	Results []*SweepResult
+}
+
+// Count returns number of results with status.
+func (r *SweepReport) Count(status SweepStatus) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// SweepAuthorized captures payments which remain authorized in a window, e.g.
+// when capture call after payment failed. Payment of an order is captured for
+// its amount and currency only if it does not exceed amount due of the order,
+// so that later instalments of partially payable order are captured too. It
+// returns error only if payments could not be listed, and capture failures are
+// reported in results.
+func (c *Client) SweepAuthorized(ctx context.Context, params *SweepParams) (*SweepReport, error) {
+	if params == nil || params.From.IsZero() || params.To.IsZero() {
+		return nil, errors.New("from and to of window are required")
+	}
+	if !params.From.Before(params.To) {
+		return nil, errors.New("from must be before to")
+	}
+
+	payments, err := c.listAuthorized(ctx, params.From, params.To)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SweepReport{}
+	orders := &order.Client{Client: c.Client}
+	for _, payment := range payments {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+		report.Results = append(report.Results, c.sweep(ctx, orders, payment, params))
+	}
+	return report, nil
+}
+
+// SweepAuthorized captures payments which remain authorized in a window.
+func SweepAuthorized(ctx context.Context, params *SweepParams) (*SweepReport, error) {
+	return getDefaultClient().SweepAuthorized(ctx, params)
+}
+
+// listAuthorized returns authorized payments created in window, paginating
+// through all.
+func (c *Client) listAuthorized(ctx context.Context, from time.Time, to time.Time) ([]*razorpay.Payment, error) {
+	payments := []*razorpay.Payment{}
+	listParams := &razorpay.PaymentListParams{}
+	listParams.From = razorpay.Int64(from.Unix())
+	listParams.To = razorpay.Int64(to.Unix())
+	listParams.Count = razorpay.Int64(sweepPageSize)
+	for skip := int64(0); ; skip += sweepPageSize {
+		listParams.Skip = razorpay.Int64(skip)
+		paymentList, err := c.List(ctx, listParams)
+		if err != nil {
+			return nil, err
+		}
+		for _, payment := range paymentList.Payments {
+			if payment.Status == razorpay.PaymentStatusAuthorized {
+				payments = append(payments, payment)
+			}
+		}
+		if len(paymentList.Payments) < sweepPageSize {
+			return payments, nil
+		}
+	}
+}
+
+// sweep captures payment, if it is to be captured.
+func (c *Client) sweep(ctx context.Context, orders *order.Client, payment *razorpay.Payment, params *SweepParams) *SweepResult {
+	result := &SweepResult{PaymentID: payment.ID, OrderID: payment.OrderID, Amount: payment.Amount, Currency: payment.Currency}
+
+	if payment.OrderID == "" {
+		if params.RequireOrder {
+			result.Status, result.Reason = SweepStatusSkipped, "payment is not made against an order"
+			return result
+		}
+	} else if reason, err := c.orderSkipReason(ctx, orders, payment); err != nil {
+		return failed(result, err)
+	} else if reason != "" {
+		result.Status, result.Reason = SweepStatusSkipped, reason
+		return result
+	}
+
+	if params.DryRun {
+		result.Status = SweepStatusCapturable
+		return result
+	}
+
+	captureParams := &razorpay.PaymentCaptureParams{Amount: razorpay.Int64(payment.Amount), Currency: payment.Currency.Ptr()}
+	if _, err := c.Capture(ctx, payment.ID, captureParams); err != nil {
+		if c.isCaptured(ctx, payment.ID, err) {
+			result.Status = SweepStatusAlreadyCaptured
+			return result
+		}
+		return failed(result, err)
+	}
+	result.Status = SweepStatusCaptured
+	return result
+}
+
+// orderSkipReason returns reason to not capture payment of order, if any.
+func (c *Client) orderSkipReason(ctx context.Context, orders *order.Client, payment *razorpay.Payment) (string, error) {
+	o, err := orders.Get(ctx, payment.OrderID, nil)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case o.Currency != payment.Currency:
+		return "currency of payment does not match order", nil
+	case o.Status == razorpay.OrderStatusPaid:
+		return "order is already paid", nil
+	case payment.Amount > o.AmountDue:
+		return "amount of payment exceeds amount due of order", nil
+	}
+	return "", nil
+}
+
+// isCaptured returns if payment is captured even though capture failed with
+// err, e.g. as a retried capture call captured it meanwhile.
+func (c *Client) isCaptured(ctx context.Context, id string, err error) bool {
+	var razorpayErr *razorpay.Error
+	if !errors.As(err, &razorpayErr) || razorpayErr.Code != "BAD_REQUEST_ERROR" {
+		return false
+	}
+	payment, err := c.Get(ctx, id, nil)
+	return err == nil && payment.Status == razorpay.PaymentStatusCaptured
+}
+
+func failed(result *SweepResult, err error) *SweepResult {
+	result.Status, result.Reason = SweepStatusFailed, err.Error()
+	result.Temporary = razorpay.IsTemporaryError(err)
+	var razorpayErr *razorpay.Error
+	if errors.As(err, &razorpayErr) {
+		result.Reason, result.ErrorCode = razorpayErr.Description, razorpayErr.Code
+	}
+	return result
+}
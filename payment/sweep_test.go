@@ -0,0 +1,106 @@
+package payment
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+	"github.com/jitendra-1217/razorpay-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_SweepAuthorized(t *testing.T) {
+	captured := []string{}
+	orders := map[string]*razorpay.Order{
+		// Other payment of first order was auto-refunded uncaptured.
+		"order_00000000000001": {Amount: 100, AmountDue: 100, Status: razorpay.OrderStatusAttempted},
+		"order_00000000000002": {Amount: 100, AmountPaid: 100, Status: razorpay.OrderStatusPaid},
+		// Third order is partially paid by first instalment.
+		"order_00000000000003": {Amount: 300, AmountPaid: 100, AmountDue: 200, Status: razorpay.OrderStatusAttempted, PartialPayment: true},
+	}
+	backend := testutil.BackendFunc(func(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error {
+		switch {
+		case path == "v1/payments":
+			v.(*razorpay.PaymentList).Payments = []*razorpay.Payment{
+				{Entity: razorpay.Entity{ID: "pay_00000000000001"}, Status: razorpay.PaymentStatusAuthorized, OrderID: "order_00000000000001", Amount: 100, Currency: razorpay.CurrencyINR},
+				{Entity: razorpay.Entity{ID: "pay_00000000000002"}, Status: razorpay.PaymentStatusAuthorized, OrderID: "order_00000000000002", Amount: 100, Currency: razorpay.CurrencyINR},
+				{Entity: razorpay.Entity{ID: "pay_00000000000003"}, Status: razorpay.PaymentStatusAuthorized, Amount: 200, Currency: razorpay.CurrencyINR},
+				{Entity: razorpay.Entity{ID: "pay_00000000000004"}, Status: razorpay.PaymentStatusAuthorized, Amount: 300, Currency: razorpay.CurrencyUSD},
+				{Entity: razorpay.Entity{ID: "pay_00000000000005"}, Status: razorpay.PaymentStatusCaptured, Amount: 100, Currency: razorpay.CurrencyINR},
+				{Entity: razorpay.Entity{ID: "pay_00000000000006"}, Status: razorpay.PaymentStatusAuthorized, OrderID: "order_00000000000003", Amount: 200, Currency: razorpay.CurrencyINR},
+				{Entity: razorpay.Entity{ID: "pay_00000000000007"}, Status: razorpay.PaymentStatusAuthorized, OrderID: "order_00000000000003", Amount: 300, Currency: razorpay.CurrencyINR},
+				{Entity: razorpay.Entity{ID: "pay_00000000000008"}, Status: razorpay.PaymentStatusAuthorized, Amount: 100, Currency: razorpay.CurrencyINR},
+			}
+		case strings.HasPrefix(path, "v1/orders/"):
+			order := v.(*razorpay.Order)
+			*order = *orders[strings.TrimPrefix(path, "v1/orders/")]
+			order.Currency = razorpay.CurrencyINR
+		case strings.HasSuffix(path, "/capture"):
+			paymentID := strings.Split(path, "/")[2]
+			captureParams := params.(*razorpay.PaymentCaptureParams)
+			switch paymentID {
+			case "pay_00000000000003", "pay_00000000000008":
+				return &razorpay.Error{StatusCode: http.StatusBadRequest, Code: "BAD_REQUEST_ERROR", Description: "Capture failed"}
+			case "pay_00000000000004":
+				assert.Equal(t, razorpay.CurrencyUSD, *captureParams.Currency)
+				return &razorpay.Error{StatusCode: http.StatusInternalServerError, Code: "SERVER_ERROR", Description: "The server encountered an error"}
+			case "pay_00000000000006":
+				assert.Equal(t, int64(200), *captureParams.Amount)
+			}
+			captured = append(captured, paymentID)
+		case strings.HasPrefix(path, "v1/payments/"):
+			// Third payment was captured meanwhile, whereas eighth was not.
+			payment := v.(*razorpay.Payment)
+			payment.Status = razorpay.PaymentStatusAuthorized
+			if path == "v1/payments/pay_00000000000003" {
+				payment.Status = razorpay.PaymentStatusCaptured
+			}
+		}
+		return nil
+	})
+	client := NewClient("key", "secret", backend)
+	now := time.Now()
+	from, to := now.Add(-72*time.Hour), now
+
+	// Case: Window is required.
+	_, err := client.SweepAuthorized(context.Background(), nil)
+	assert.NotNil(t, err)
+	_, err = client.SweepAuthorized(context.Background(), &SweepParams{From: to, To: from})
+	assert.NotNil(t, err)
+
+	// Case: Dry run does not capture.
+	report, err := client.SweepAuthorized(context.Background(), &SweepParams{From: from, To: to, DryRun: true})
+	assert.Nil(t, err)
+	assert.Equal(t, 5, report.Count(SweepStatusCapturable))
+	assert.Equal(t, 2, report.Count(SweepStatusSkipped))
+	assert.Empty(t, captured)
+
+	// Case: Captures.
+	report, err = client.SweepAuthorized(context.Background(), &SweepParams{From: from, To: to})
+	assert.Nil(t, err)
+	assert.Len(t, report.Results, 7)
+	statuses := map[string]SweepStatus{}
+	for _, result := range report.Results {
+		statuses[result.PaymentID] = result.Status
+	}
+	assert.Equal(t, map[string]SweepStatus{
+		"pay_00000000000001": SweepStatusCaptured,
+		"pay_00000000000002": SweepStatusSkipped,
+		"pay_00000000000003": SweepStatusAlreadyCaptured,
+		"pay_00000000000004": SweepStatusFailed,
+		"pay_00000000000006": SweepStatusCaptured,
+		"pay_00000000000007": SweepStatusSkipped,
+		"pay_00000000000008": SweepStatusFailed,
+	}, statuses)
+	assert.Equal(t, []string{"pay_00000000000001", "pay_00000000000006"}, captured)
+	assert.Equal(t, "SERVER_ERROR", report.Results[3].ErrorCode)
+	assert.True(t, report.Results[3].Temporary)
+
+	// Case: Payments without order are skipped when order is required.
+	report, err = client.SweepAuthorized(context.Background(), &SweepParams{From: from, To: to, RequireOrder: true, DryRun: true})
+	assert.Nil(t, err)
+	assert.Equal(t, 5, report.Count(SweepStatusSkipped))
+}
@@ -0,0 +1,34 @@
+package razorpay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayment_Expand(t *testing.T) {
+	body := []byte(`{
+		"id": "pay_00000000000001",
+		"entity": "payment",
+		"method": "emi",
+		"card": {"id": "card_00000000000001", "entity": "card", "last4": "1111", "network": "Visa", "type": "credit", "emi": true},
+		"emi": {"issuer": "HDFC", "type": "credit", "rate": 1300, "duration": 6},
+		"offers": {"entity": "collection", "count": 1, "items": [{"id": "offer_00000000000001"}]},
+		"upi": {"payer_account_type": "bank_account", "vpa": "gaurav.kumar@exampleupi", "flow": "collect"}
+	}`)
+	payment := &Payment{}
+	warnings, err := UnmarshalTolerant(body, payment)
+	assert.Nil(t, err)
+	assert.Empty(t, warnings)
+	assert.Equal(t, "card_00000000000001", payment.Card.ID)
+	assert.Equal(t, CardTypeCredit, payment.Card.Type)
+	assert.Equal(t, int64(6), payment.EMI.Duration)
+	assert.Equal(t, "offer_00000000000001", payment.Offers.Offers[0].ID)
+	assert.Equal(t, PaymentUPIFlowCollect, payment.UPI.Flow)
+
+	// Case: Not expanded.
+	payment = &Payment{}
+	_, err = UnmarshalTolerant([]byte(`{"id": "pay_00000000000001"}`), payment)
+	assert.Nil(t, err)
+	assert.Nil(t, payment.Card)
+}
@@ -1,13 +1,16 @@
 package razorpay
 
-import "encoding/json"
+import (
+	"reflect"
+	"sort"
+)
 
 // PaymentLink is a Razorpay entity representation.
 type PaymentLink struct {
 	Response
 	Entity
 	Amount                int64                       `json:"amount"`
-	Currency              string                      `json:"currency"`
+	Currency              Currency                    `json:"currency"`
 	AcceptPartial         bool                        `json:"accept_partial"`
 	FirstMinPartialAmount int64                       `json:"first_min_partial_amount"`
 	AmountPaid            int64                       `json:"amount_paid"`
@@ -23,10 +26,69 @@ type PaymentLink struct {
 	ReminderEnable        bool                        `json:"reminder_enable"`
 	Reminders             PaymentLinkRemindersWrapper `json:"reminders"`
 	ShortUrl              string                      `json:"short_url"`
-	Status                string                      `json:"status"`
+	Status                PaymentLinkStatus           `json:"status"`
 	Notes                 Notes                       `json:"notes"`
+	Payments              PaymentLinkPayments         `json:"payments"`
+	UPILink               bool                        `json:"upi_link"`
+	OrderID               string                      `json:"order_id"`
+	UpdatedAt             int64                       `json:"updated_at"`
+}
+
+// AmountDue returns amount yet to be paid.
+func (l *PaymentLink) AmountDue() int64 {
+	return l.Amount - l.AmountPaid
+}
+
+// PaymentLinkTimelineEntry is a captured payment against payment link, with
+// amount paid and due after the same.
+type PaymentLinkTimelineEntry struct {
+	Payment    *PaymentLinkPayment
+	AmountPaid int64
+	AmountDue  int64
+}
+
+// Timeline returns captured payments in order of creation, with running amount
+// paid and due, to track partial payments toward AmountPaid.
+func (l *PaymentLink) Timeline() []*PaymentLinkTimelineEntry {
+	payments := make([]*PaymentLinkPayment, 0, len(l.Payments))
+	for _, payment := range l.Payments {
+		if payment.Status == PaymentStatusCaptured {
+			payments = append(payments, payment)
+		}
+	}
+	sort.SliceStable(payments, func(i, j int) bool {
+		return payments[i].CreatedAt < payments[j].CreatedAt
+	})
 
-	// TODO: To add `Payments` field in the struct. Need to handle `[]` i.e. empty list as value.
+	timeline := make([]*PaymentLinkTimelineEntry, 0, len(payments))
+	amountPaid := int64(0)
+	for _, payment := range payments {
+		amountPaid += payment.Amount
+		timeline = append(timeline, &PaymentLinkTimelineEntry{payment, amountPaid, l.Amount - amountPaid})
+	}
+	return timeline
+}
+
+// PaymentLinkStatus is status of payment link. Unknown values are retained as
+// is when unmarshalling, and so IsValid can be used to detect them.
+type PaymentLinkStatus string
+
+// List of payment link statuses.
+const (
+	PaymentLinkStatusCreated       PaymentLinkStatus = "created"
+	PaymentLinkStatusPartiallyPaid PaymentLinkStatus = "partially_paid"
+	PaymentLinkStatusPaid          PaymentLinkStatus = "paid"
+	PaymentLinkStatusExpired       PaymentLinkStatus = "expired"
+	PaymentLinkStatusCancelled     PaymentLinkStatus = "cancelled"
+)
+
+// IsValid returns if status is one of known statuses.
+func (s PaymentLinkStatus) IsValid() bool {
+	switch s {
+	case PaymentLinkStatusCreated, PaymentLinkStatusPartiallyPaid, PaymentLinkStatusPaid, PaymentLinkStatusExpired, PaymentLinkStatusCancelled:
+		return true
+	}
+	return false
 }
 
 type PaymentLinkNotify struct {
@@ -45,37 +107,111 @@ type PaymentLinkRemindersWrapper struct {
 
 // UnmarshalJSON unmarshals raw `reminders` into the type.
 func (w *PaymentLinkRemindersWrapper) UnmarshalJSON(data []byte) error {
-	// In json response, `reminders` will appear slice i.e. `[]` when it is empty,
-	// and so only attempts unmarshal when it appears object.
-	if len(data) > 0 && data[0] == '{' {
-		return json.Unmarshal(data, w.PaymentLinkReminders)
+	// In json response, `reminders` will appear slice i.e. `[]` when it is empty.
+	reminders := &PaymentLinkReminders{}
+	if _, err := UnmarshalTolerant(data, reminders); err != nil {
+		return err
+	}
+	if *reminders != (PaymentLinkReminders{}) {
+		w.PaymentLinkReminders = reminders
 	}
 	return nil
 }
 
+func (w *PaymentLinkRemindersWrapper) tolerantShape() reflect.Type {
+	return reflect.TypeOf(PaymentLinkReminders{})
+}
+
+// PaymentLinkPayment is a payment made against payment link.
+type PaymentLinkPayment struct {
+	Amount    int64         `json:"amount"`
+	CreatedAt int64         `json:"created_at"`
+	Method    PaymentMethod `json:"method"`
+	PaymentID string        `json:"payment_id"`
+	PlinkID   string        `json:"plink_id"`
+	Status    PaymentStatus `json:"status"`
+	UpdatedAt int64         `json:"updated_at"`
+}
+
+// PaymentLinkPayments is list of payments made against payment link.
+type PaymentLinkPayments []*PaymentLinkPayment
+
+// UnmarshalJSON unmarshals raw `payments` into the type.
+func (p *PaymentLinkPayments) UnmarshalJSON(data []byte) error {
+	// In json response, `payments` will appear null when there is none.
+	var payments []*PaymentLinkPayment
+	if _, err := UnmarshalTolerant(data, &payments); err != nil {
+		return err
+	}
+	*p = payments
+	return nil
+}
+
+func (p *PaymentLinkPayments) tolerantShape() reflect.Type {
+	return reflect.TypeOf([]*PaymentLinkPayment{})
+}
+
 // PaymentLinkList is collection of payment links.
 type PaymentLinkList struct {
 	Response
 	EntityList
-	PaymentLinks []*PaymentLink `json:"items"`
+	PaymentLinks []*PaymentLink `json:"payment_links"`
+}
+
+// UnmarshalJSON unmarshals raw list response into the type.
+func (l *PaymentLinkList) UnmarshalJSON(data []byte) error {
+	// Unlike other collections, payment links appear under `payment_links`
+	// key and without count. So count is derived from the same.
+	type alias PaymentLinkList
+	warnings, err := UnmarshalTolerant(data, (*alias)(l))
+	if err != nil {
+		return err
+	}
+	l.AddWarnings(warnings)
+	if l.Count == 0 {
+		l.Count = int64(len(l.PaymentLinks))
+	}
+	return nil
 }
 
 // PaymentLinkParams is list of params that can be used when creating or updating payment link.
 type PaymentLinkParams struct {
 	Params
-	Amount                *int64                   `json:"amount,omitempty"`
-	Currency              *string                  `json:"currency,omitempty"`
-	AcceptPartial         *bool                    `json:"accept_partial,omitempty"`
-	FirstMinPartialAmount *int64                   `json:"first_min_partial_amount,omitempty"`
-	Description           *string                  `json:"description,omitempty"`
-	Customer              *CustomerParams          `json:"customer,omitempty"`
-	CallbackMethod        *string                  `json:"callback_method,omitempty"`
-	CallbackUrl           *string                  `json:"callback_url,omitempty"`
-	ExpireBy              *int64                   `json:"expire_by,omitempty"`
-	Notify                *PaymentLinkNotifyParams `json:"notify,omitempty"`
-	ReferenceID           *string                  `json:"reference_id,omitempty"`
-	ReminderEnable        *bool                    `json:"reminder_enable,omitempty"`
-	Notes                 Notes                    `json:"notes,omitempty"`
+	Amount                *int64                    `json:"amount,omitempty"`
+	Currency              *Currency                 `json:"currency,omitempty"`
+	AcceptPartial         *bool                     `json:"accept_partial,omitempty"`
+	FirstMinPartialAmount *int64                    `json:"first_min_partial_amount,omitempty"`
+	Description           *string                   `json:"description,omitempty"`
+	Customer              *CustomerParams           `json:"customer,omitempty"`
+	CallbackMethod        *string                   `json:"callback_method,omitempty"`
+	CallbackUrl           *string                   `json:"callback_url,omitempty"`
+	ExpireBy              *int64                    `json:"expire_by,omitempty"`
+	Notify                *PaymentLinkNotifyParams  `json:"notify,omitempty"`
+	ReferenceID           *string                   `json:"reference_id,omitempty"`
+	ReminderEnable        *bool                     `json:"reminder_enable,omitempty"`
+	Notes                 Notes                     `json:"notes,omitempty"`
+	UPILink               *bool                     `json:"upi_link,omitempty"`
+	Options               *PaymentLinkOptionsParams `json:"options,omitempty"`
+}
+
+// SetAmount sets amount and currency from money.
+func (p *PaymentLinkParams) SetAmount(m Money) {
+	p.Amount, p.Currency = Int64(m.Amount), m.Currency.Ptr()
+}
+
+// PaymentLinkNotifyMedium is medium by which notification or reminder of
+// payment link is sent to customer.
+type PaymentLinkNotifyMedium string
+
+// List of payment link notify mediums.
+const (
+	PaymentLinkNotifyMediumSMS   PaymentLinkNotifyMedium = "sms"
+	PaymentLinkNotifyMediumEmail PaymentLinkNotifyMedium = "email"
+)
+
+// IsValid returns if medium is one of known mediums.
+func (m PaymentLinkNotifyMedium) IsValid() bool {
+	return m == PaymentLinkNotifyMediumSMS || m == PaymentLinkNotifyMediumEmail
 }
 
 // PaymentLinkNotifyParams is part of PaymentLinkParams.
@@ -84,6 +220,66 @@ type PaymentLinkNotifyParams struct {
 	SMS   *bool `json:"sms,omitempty"`
 }
 
+// PaymentLinkOptionsParams is part of PaymentLinkParams to customise checkout
+// and hosted page.
+type PaymentLinkOptionsParams struct {
+	Checkout   *PaymentLinkCheckoutOptionsParams   `json:"checkout,omitempty"`
+	HostedPage *PaymentLinkHostedPageOptionsParams `json:"hosted_page,omitempty"`
+}
+
+// PaymentLinkCheckoutOptionsParams is part of PaymentLinkOptionsParams.
+type PaymentLinkCheckoutOptionsParams struct {
+	Name           *string                                  `json:"name,omitempty"`
+	Theme          *PaymentLinkCheckoutThemeParams          `json:"theme,omitempty"`
+	Method         map[PaymentMethod]string                 `json:"method,omitempty"`
+	Prefill        map[string]string                        `json:"prefill,omitempty"`
+	Readonly       *PaymentLinkCheckoutReadonlyParams       `json:"readonly,omitempty"`
+	PartialPayment *PaymentLinkCheckoutPartialPaymentParams `json:"partial_payment,omitempty"`
+}
+
+// PaymentLinkCheckoutThemeParams is part of PaymentLinkCheckoutOptionsParams.
+type PaymentLinkCheckoutThemeParams struct {
+	HideTopbar *bool `json:"hide_topbar,omitempty"`
+}
+
+// PaymentLinkCheckoutReadonlyParams is part of PaymentLinkCheckoutOptionsParams.
+type PaymentLinkCheckoutReadonlyParams struct {
+	Email   *bool `json:"email,omitempty"`
+	Contact *bool `json:"contact,omitempty"`
+}
+
+// PaymentLinkCheckoutPartialPaymentParams is part of PaymentLinkCheckoutOptionsParams.
+type PaymentLinkCheckoutPartialPaymentParams struct {
+	MinAmountLabel           *string `json:"min_amount_label,omitempty"`
+	PartialAmountLabel       *string `json:"partial_amount_label,omitempty"`
+	PartialAmountDescription *string `json:"partial_amount_description,omitempty"`
+	FullAmountLabel          *string `json:"full_amount_label,omitempty"`
+}
+
+// PaymentLinkHostedPageOptionsParams is part of PaymentLinkOptionsParams.
+type PaymentLinkHostedPageOptionsParams struct {
+	Label           *PaymentLinkHostedPageLabelParams           `json:"label,omitempty"`
+	ShowPreferences *PaymentLinkHostedPageShowPreferencesParams `json:"show_preferences,omitempty"`
+}
+
+// PaymentLinkHostedPageLabelParams is part of PaymentLinkHostedPageOptionsParams.
+type PaymentLinkHostedPageLabelParams struct {
+	Receipt           *string `json:"receipt,omitempty"`
+	Description       *string `json:"description,omitempty"`
+	AmountPayable     *string `json:"amount_payable,omitempty"`
+	AmountPaid        *string `json:"amount_paid,omitempty"`
+	AmountDue         *string `json:"amount_due,omitempty"`
+	PartialAmountDue  *string `json:"partial_amount_due,omitempty"`
+	PartialAmountPaid *string `json:"partial_amount_paid,omitempty"`
+	ExpireBy          *string `json:"expire_by,omitempty"`
+	ExpiredOn         *string `json:"expired_on,omitempty"`
+}
+
+// PaymentLinkHostedPageShowPreferencesParams is part of PaymentLinkHostedPageOptionsParams.
+type PaymentLinkHostedPageShowPreferencesParams struct {
+	IssuedTo *bool `json:"issued_to,omitempty"`
+}
+
 // PaymentLinkListParams is list params that can be used when listing payment links.
 type PaymentLinkListParams struct {
 	ListParams
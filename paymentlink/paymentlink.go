@@ -2,6 +2,7 @@ package paymentlink
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	razorpay "github.com/jitendra-1217/razorpay-go"
@@ -15,6 +16,9 @@ type Client struct {
 // Create creates new payment link.
 func (c *Client) Create(ctx context.Context, params *razorpay.PaymentLinkParams) (*razorpay.PaymentLink, error) {
 	paymentLink := &razorpay.PaymentLink{}
+	if err := c.ValidateParams(params); err != nil {
+		return paymentLink, err
+	}
 	err := c.Call(ctx, http.MethodPost, "/payment_links", params, paymentLink)
 	return paymentLink, err
 }
@@ -26,6 +30,24 @@ func (c *Client) Update(ctx context.Context, id string, params *razorpay.Payment
 	return paymentLink, err
 }
 
+// CreateUPI creates new UPI payment link.
+func (c *Client) CreateUPI(ctx context.Context, params *razorpay.PaymentLinkParams) (*razorpay.PaymentLink, error) {
+	upiParams := razorpay.PaymentLinkParams{}
+	if params != nil {
+		upiParams = *params
+	}
+	upiParams.UPILink = razorpay.Bool(true)
+	return c.Create(ctx, &upiParams)
+}
+
+// SetReminders enables or disables reminders for payment link. Schedule of
+// reminders is configured on dashboard, and reminder can be sent right away
+// with NotifyBy.
+func (c *Client) SetReminders(ctx context.Context, id string, enable bool) (*razorpay.PaymentLink, error) {
+	params := &razorpay.PaymentLinkParams{ReminderEnable: razorpay.Bool(enable)}
+	return c.Update(ctx, id, params)
+}
+
 // Get returns payment link for id.
 func (c *Client) Get(ctx context.Context, id string, params *razorpay.GetParams) (*razorpay.PaymentLink, error) {
 	if params == nil {
@@ -37,21 +59,29 @@ func (c *Client) Get(ctx context.Context, id string, params *razorpay.GetParams)
 	return paymentLink, err
 }
 
-// // List returns list of payment links for params.
-// TODO: Uncomment after handling custom unmarshalling. The response in this case is not same as in common contract.
-// func (c *Client) List(ctx context.Context, params *razorpay.PaymentLinkListParams) (*razorpay.PaymentLinkList, error) {
-// 	if params == nil {
-// 		params = &razorpay.PaymentLinkListParams{}
-// 	}
+// List returns list of payment links for params.
+func (c *Client) List(ctx context.Context, params *razorpay.PaymentLinkListParams) (*razorpay.PaymentLinkList, error) {
+	if params == nil {
+		params = &razorpay.PaymentLinkListParams{}
+	}
 
-// 	paymentLinkList := &razorpay.PaymentLinkList{}
-// 	err := c.Call(ctx, http.MethodGet, "/payment_links", params, paymentLinkList)
-// 	return paymentLinkList, err
-// }
+	paymentLinkList := &razorpay.PaymentLinkList{}
+	err := c.Call(ctx, http.MethodGet, "/payment_links", params, paymentLinkList)
+	return paymentLinkList, err
+}
 
 // Notify sends or resends notifications for payment link.
 func (c *Client) Notify(ctx context.Context, id string, medium string) error {
-	return c.Call(ctx, http.MethodPost, "/payment_links/"+id+"/notify_by/"+medium, nil, nil)
+	return c.NotifyBy(ctx, id, razorpay.PaymentLinkNotifyMedium(medium))
+}
+
+// NotifyBy sends or resends notification for payment link by medium, e.g. as
+// reminder to customer.
+func (c *Client) NotifyBy(ctx context.Context, id string, medium razorpay.PaymentLinkNotifyMedium) error {
+	if !medium.IsValid() {
+		return razorpay.ValidationErrors{{Field: "medium", Description: fmt.Sprintf("medium %q is invalid", medium)}}
+	}
+	return c.Call(ctx, http.MethodPost, "/payment_links/"+id+"/notify_by/"+string(medium), nil, nil)
 }
 
 // Cancel cancels payment link.
@@ -71,21 +101,36 @@ func Update(ctx context.Context, id string, params *razorpay.PaymentLinkParams)
 	return getDefaultClient().Update(ctx, id, params)
 }
 
+// CreateUPI creates new UPI payment link.
+func CreateUPI(ctx context.Context, params *razorpay.PaymentLinkParams) (*razorpay.PaymentLink, error) {
+	return getDefaultClient().CreateUPI(ctx, params)
+}
+
+// SetReminders enables or disables reminders for payment link.
+func SetReminders(ctx context.Context, id string, enable bool) (*razorpay.PaymentLink, error) {
+	return getDefaultClient().SetReminders(ctx, id, enable)
+}
+
 // Get returns payment link for id.
 func Get(ctx context.Context, id string, params *razorpay.GetParams) (*razorpay.PaymentLink, error) {
 	return getDefaultClient().Get(ctx, id, params)
 }
 
-// // List returns list of payment links for params.
-// func List(ctx context.Context, params *razorpay.PaymentLinkListParams) (*razorpay.PaymentLinkList, error) {
-// 	return getDefaultClient().List(ctx, params)
-// }
+// List returns list of payment links for params.
+func List(ctx context.Context, params *razorpay.PaymentLinkListParams) (*razorpay.PaymentLinkList, error) {
+	return getDefaultClient().List(ctx, params)
+}
 
 // Notify sends or resends notifications for payment link.
 func Notify(ctx context.Context, id string, medium string) error {
 	return getDefaultClient().Notify(ctx, id, medium)
 }
 
+// NotifyBy sends or resends notification for payment link by medium.
+func NotifyBy(ctx context.Context, id string, medium razorpay.PaymentLinkNotifyMedium) error {
+	return getDefaultClient().NotifyBy(ctx, id, medium)
+}
+
 // Cancel cancels payment link.
 func Cancel(ctx context.Context, id string) (*razorpay.PaymentLink, error) {
 	return getDefaultClient().Cancel(ctx, id)
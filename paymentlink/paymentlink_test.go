@@ -23,7 +23,7 @@ func TestClient_Create(t *testing.T) {
 	customerEmail := strings.ToLower(faker.Email())
 	params := &razorpay.PaymentLinkParams{
 		Amount:      razorpay.Int64(123),
-		Currency:    razorpay.String("INR"),
+		Currency:    razorpay.CurrencyINR.Ptr(),
 		Description: &description,
 		Customer: &razorpay.CustomerParams{
 			Name:    &customerName,
@@ -61,18 +61,52 @@ func TestClient_Update(t *testing.T) {
 	assert.Equal(t, referenceID, paymentLink.ReferenceID)
 }
 
+func TestClient_NotifyBy(t *testing.T) {
+	paths := []string{}
+	client := NewClient("key", "secret", testutil.BackendFunc(func(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error {
+		paths = append(paths, path)
+		return nil
+	}))
+	assert.Nil(t, client.NotifyBy(context.Background(), "plink_00000000000001", razorpay.PaymentLinkNotifyMediumSMS))
+	assert.Nil(t, client.Notify(context.Background(), "plink_00000000000001", "email"))
+	err := client.NotifyBy(context.Background(), "plink_00000000000001", "whatsapp")
+	assert.EqualError(t, err, `field: medium, description: medium "whatsapp" is invalid`)
+	assert.Equal(t, []string{"v1/payment_links/plink_00000000000001/notify_by/sms", "v1/payment_links/plink_00000000000001/notify_by/email"}, paths)
+}
+
+func TestClient_SetReminders(t *testing.T) {
+	paymentLink, err := SetReminders(context.Background(), paymentLinkID, false)
+	assert.Nil(t, err)
+	assert.False(t, paymentLink.ReminderEnable)
+}
+
+func TestClient_CreateUPI(t *testing.T) {
+	description := faker.Sentence()
+	params := &razorpay.PaymentLinkParams{
+		Amount:      razorpay.Int64(123),
+		Currency:    razorpay.CurrencyINR.Ptr(),
+		Description: &description,
+	}
+	paymentLink, err := CreateUPI(context.Background(), params)
+	assert.Nil(t, err)
+	assert.True(t, testutil.IsAnyID(paymentLink.ID))
+	assert.True(t, paymentLink.UPILink)
+	assert.Nil(t, params.UPILink)
+}
+
 func TestClient_Get(t *testing.T) {
 	paymentLink, err := Get(context.Background(), paymentLinkID, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, paymentLinkID, paymentLink.ID)
 }
 
-// func TestClient_List(t *testing.T) {
-// 	params := &razorpay.PaymentLinkListParams{}
-// 	paymentLinkList, err := List(context.Background(), params)
-// 	assert.Nil(t, err)
-// 	assert.True(t, paymentLinkList.Count > 0)
-// }
+func TestClient_List(t *testing.T) {
+	params := &razorpay.PaymentLinkListParams{}
+	paymentLinkList, err := List(context.Background(), params)
+	assert.Nil(t, err)
+	assert.True(t, paymentLinkList.Count > 0)
+	assert.Equal(t, int64(len(paymentLinkList.PaymentLinks)), paymentLinkList.Count)
+}
 
 func TestClient_Notify(t *testing.T) {
 	err := Notify(context.Background(), paymentLinkID, "email")
@@ -82,5 +116,5 @@ func TestClient_Notify(t *testing.T) {
 func TestClient_Cancel(t *testing.T) {
 	paymentLink, err := Cancel(context.Background(), paymentLinkID)
 	assert.Nil(t, err)
-	assert.Equal(t, "cancelled", paymentLink.Status)
+	assert.Equal(t, razorpay.PaymentLinkStatusCancelled, paymentLink.Status)
 }
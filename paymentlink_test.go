@@ -0,0 +1,71 @@
+package razorpay
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentLink_Timeline(t *testing.T) {
+	paymentLink := &PaymentLink{
+		Amount:     1000,
+		AmountPaid: 700,
+		Payments: PaymentLinkPayments{
+			{Amount: 400, CreatedAt: 30, PaymentID: "pay_00000000000003", Status: PaymentStatusCaptured},
+			{Amount: 300, CreatedAt: 10, PaymentID: "pay_00000000000001", Status: PaymentStatusCaptured},
+			{Amount: 300, CreatedAt: 20, PaymentID: "pay_00000000000002", Status: PaymentStatusFailed},
+		},
+	}
+	timeline := paymentLink.Timeline()
+	assert.Len(t, timeline, 2)
+	assert.Equal(t, "pay_00000000000001", timeline[0].Payment.PaymentID)
+	assert.Equal(t, int64(300), timeline[0].AmountPaid)
+	assert.Equal(t, int64(700), timeline[0].AmountDue)
+	assert.Equal(t, "pay_00000000000003", timeline[1].Payment.PaymentID)
+	assert.Equal(t, int64(700), timeline[1].AmountPaid)
+	assert.Equal(t, int64(300), timeline[1].AmountDue)
+	assert.Equal(t, paymentLink.AmountDue(), timeline[1].AmountDue)
+}
+
+func TestPaymentLinkList_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{
+		"payment_links": [
+			{
+				"id": "plink_00000000000001",
+				"amount": 1000,
+				"amount_paid": 1000,
+				"notes": [],
+				"reminders": [],
+				"payments": [
+					{"amount": 1000, "method": "upi", "payment_id": "pay_00000000000001", "plink_id": "plink_00000000000001", "status": "captured"}
+				],
+				"status": "paid"
+			},
+			{
+				"id": "plink_00000000000002",
+				"amount": 1000,
+				"notes": {"key": "value"},
+				"reminders": {"status": "failed"},
+				"payments": null,
+				"status": "created"
+			}
+		]
+	}`)
+	paymentLinkList := &PaymentLinkList{}
+	err := json.Unmarshal(data, paymentLinkList)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), paymentLinkList.Count)
+
+	paymentLink := paymentLinkList.PaymentLinks[0]
+	assert.Nil(t, paymentLink.Notes)
+	assert.Nil(t, paymentLink.Reminders.PaymentLinkReminders)
+	assert.Len(t, paymentLink.Payments, 1)
+	assert.Equal(t, "pay_00000000000001", paymentLink.Payments[0].PaymentID)
+	assert.Equal(t, PaymentStatusCaptured, paymentLink.Payments[0].Status)
+
+	paymentLink = paymentLinkList.PaymentLinks[1]
+	assert.Equal(t, "value", paymentLink.Notes["key"])
+	assert.Equal(t, "failed", paymentLink.Reminders.Status)
+	assert.Len(t, paymentLink.Payments, 0)
+}
@@ -0,0 +1,286 @@
+package razorpay
+
+// Payout is a RazorpayX entity representation of money transfer from account
+// to fund account of contact.
+type Payout struct {
+	Response
+	Entity
+	FundAccountID string               `json:"fund_account_id"`
+	FundAccount   *FundAccount         `json:"fund_account"`
+	Amount        int64                `json:"amount"`
+	Currency      Currency             `json:"currency"`
+	Notes         Notes                `json:"notes"`
+	Fees          int64                `json:"fees"`
+	Tax           int64                `json:"tax"`
+	Status        PayoutStatus         `json:"status"`
+	StatusDetails *PayoutStatusDetails `json:"status_details"`
+	UTR           string               `json:"utr"`
+	Mode          PayoutMode           `json:"mode"`
+	Purpose       PayoutPurpose        `json:"purpose"`
+	ReferenceID   string               `json:"reference_id"`
+	Narration     string               `json:"narration"`
+	BatchID       string               `json:"batch_id"`
+	FailureReason string               `json:"failure_reason"`
+	// IdempotencyKey is key which payout was created with, set by Create.
+	IdempotencyKey string `json:"-"`
+}
+
+// IsCancellable returns if payout can be cancelled i.e. it is queued.
+func (p *Payout) IsCancellable() bool {
+	return p.Status == PayoutStatusQueued
+}
+
+// PayoutStatusDetails is reason of current status of payout.
+type PayoutStatusDetails struct {
+	Description string `json:"description"`
+	Source      string `json:"source"`
+	Reason      string `json:"reason"`
+}
+
+// PayoutStatus is status of payout. Unknown values are retained as is when
+// unmarshalling, and so IsValid can be used to detect them.
+type PayoutStatus string
+
+// List of payout statuses.
+const (
+	// PayoutStatusPending is payout awaiting approval, in approval workflow.
+	PayoutStatusPending PayoutStatus = "pending"
+	// PayoutStatusQueued is payout waiting for sufficient balance.
+	PayoutStatusQueued     PayoutStatus = "queued"
+	PayoutStatusRejected   PayoutStatus = "rejected"
+	PayoutStatusProcessing PayoutStatus = "processing"
+	PayoutStatusProcessed  PayoutStatus = "processed"
+	PayoutStatusCancelled  PayoutStatus = "cancelled"
+	// PayoutStatusReversed is payout returned by beneficiary bank, after
+	// processing or even after being processed.
+	PayoutStatusReversed PayoutStatus = "reversed"
+	PayoutStatusFailed   PayoutStatus = "failed"
+)
+
+// payoutStatusTransitions is statuses which payout can move to from a status.
+var payoutStatusTransitions = map[PayoutStatus][]PayoutStatus{
+	PayoutStatusPending:    {PayoutStatusQueued, PayoutStatusProcessing, PayoutStatusRejected, PayoutStatusCancelled},
+	PayoutStatusQueued:     {PayoutStatusPending, PayoutStatusProcessing, PayoutStatusCancelled, PayoutStatusFailed},
+	PayoutStatusProcessing: {PayoutStatusProcessed, PayoutStatusReversed, PayoutStatusFailed},
+	PayoutStatusProcessed:  {PayoutStatusReversed},
+	PayoutStatusRejected:   {},
+	PayoutStatusCancelled:  {},
+	PayoutStatusReversed:   {},
+	PayoutStatusFailed:     {},
+}
+
+// IsValid returns if status is one of known statuses.
+func (s PayoutStatus) IsValid() bool {
+	_, ok := payoutStatusTransitions[s]
+	return ok
+}
+
+// Next returns statuses which payout can move to from status.
+func (s PayoutStatus) Next() []PayoutStatus {
+	return append([]PayoutStatus(nil), payoutStatusTransitions[s]...)
+}
+
+// CanTransitionTo returns if payout can move from status to next status.
+func (s PayoutStatus) CanTransitionTo(next PayoutStatus) bool {
+	for _, status := range payoutStatusTransitions[s] {
+		if status == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTerminal returns if status does not change further. Processed payout is
+// not terminal, as it may still be reversed.
+func (s PayoutStatus) IsTerminal() bool {
+	return s.IsValid() && len(payoutStatusTransitions[s]) == 0
+}
+
+// Ptr returns pointer to status value.
+func (s PayoutStatus) Ptr() *PayoutStatus {
+	return &s
+}
+
+// PayoutMode is mode of transfer of payout.
+type PayoutMode string
+
+// List of payout modes.
+const (
+	PayoutModeNEFT PayoutMode = "NEFT"
+	PayoutModeRTGS PayoutMode = "RTGS"
+	PayoutModeIMPS PayoutMode = "IMPS"
+	PayoutModeUPI  PayoutMode = "UPI"
+	PayoutModeCard PayoutMode = "card"
+)
+
+// payoutModeFundAccountTypes is types of fund account which payout can be
+// made to in a mode. Card payouts are made over banking and upi rails too.
+var payoutModeFundAccountTypes = map[PayoutMode][]FundAccountType{
+	PayoutModeNEFT: {FundAccountTypeBankAccount, FundAccountTypeCard},
+	PayoutModeRTGS: {FundAccountTypeBankAccount},
+	PayoutModeIMPS: {FundAccountTypeBankAccount, FundAccountTypeCard},
+	PayoutModeUPI:  {FundAccountTypeVPA, FundAccountTypeCard},
+	PayoutModeCard: {FundAccountTypeCard},
+}
+
+// IsValid returns if mode is one of known modes.
+func (m PayoutMode) IsValid() bool {
+	_, ok := payoutModeFundAccountTypes[m]
+	return ok
+}
+
+// FundAccountTypes returns types of fund account which payout can be made to
+// in mode.
+func (m PayoutMode) FundAccountTypes() []FundAccountType {
+	return append([]FundAccountType(nil), payoutModeFundAccountTypes[m]...)
+}
+
+// Supports returns if payout can be made to type of fund account in mode.
+func (m PayoutMode) Supports(accountType FundAccountType) bool {
+	for _, t := range payoutModeFundAccountTypes[m] {
+		if t == accountType {
+			return true
+		}
+	}
+	return false
+}
+
+// Ptr returns pointer to mode value.
+func (m PayoutMode) Ptr() *PayoutMode {
+	return &m
+}
+
+// PayoutPurpose is purpose of payout. Custom purposes created on dashboard
+// are retained as is.
+type PayoutPurpose string
+
+// List of payout purposes.
+const (
+	PayoutPurposeRefund      PayoutPurpose = "refund"
+	PayoutPurposeCashback    PayoutPurpose = "cashback"
+	PayoutPurposePayout      PayoutPurpose = "payout"
+	PayoutPurposeSalary      PayoutPurpose = "salary"
+	PayoutPurposeUtilityBill PayoutPurpose = "utility bill"
+	PayoutPurposeVendorBill  PayoutPurpose = "vendor bill"
+)
+
+// Ptr returns pointer to purpose value.
+func (p PayoutPurpose) Ptr() *PayoutPurpose {
+	return &p
+}
+
+// PayoutList is collection of payouts.
+type PayoutList struct {
+	Response
+	EntityList
+	Payouts []*Payout `json:"items"`
+}
+
+// PayoutParams is list of params that can be used when creating payout. Either
+// FundAccountID of existing fund account is set, or FundAccount along with its
+// contact is created with payout.
+type PayoutParams struct {
+	Params
+	// AccountNumber is RazorpayX account number, which payout is made from.
+	AccountNumber *string                  `json:"account_number,omitempty"`
+	FundAccountID *string                  `json:"fund_account_id,omitempty"`
+	FundAccount   *PayoutFundAccountParams `json:"fund_account,omitempty"`
+	Amount        *int64                   `json:"amount,omitempty"`
+	Currency      *Currency                `json:"currency,omitempty"`
+	Mode          *PayoutMode              `json:"mode,omitempty"`
+	Purpose       *PayoutPurpose           `json:"purpose,omitempty"`
+	// QueueIfLowBalance queues payout instead of failing it, if balance is
+	// insufficient.
+	QueueIfLowBalance *bool   `json:"queue_if_low_balance,omitempty"`
+	ReferenceID       *string `json:"reference_id,omitempty"`
+	// Narration appears on bank statement of beneficiary.
+	Narration *string `json:"narration,omitempty"`
+	Notes     Notes   `json:"notes,omitempty"`
+}
+
+// PayoutFundAccountParams is fund account, along with its contact, created
+// with payout.
+type PayoutFundAccountParams struct {
+	AccountType *FundAccountType              `json:"account_type,omitempty"`
+	BankAccount *FundAccountBankAccountParams `json:"bank_account,omitempty"`
+	VPA         *FundAccountVPAParams         `json:"vpa,omitempty"`
+	Card        *FundAccountCardParams        `json:"card,omitempty"`
+	Contact     *ContactParams                `json:"contact,omitempty"`
+}
+
+// PayoutListParams is list params that can be used when listing payouts.
+type PayoutListParams struct {
+	ListParams
+	AccountNumber *string       `url:"account_number,omitempty"`
+	ContactID     *string       `url:"contact_id,omitempty"`
+	FundAccountID *string       `url:"fund_account_id,omitempty"`
+	Mode          *PayoutMode   `url:"mode,omitempty"`
+	ReferenceID   *string       `url:"reference_id,omitempty"`
+	Status        *PayoutStatus `url:"status,omitempty"`
+}
+
+// PayoutLink is a RazorpayX entity representation of link sent to contact,
+// where contact enters own fund account details to receive payout.
+type PayoutLink struct {
+	Response
+	Entity
+	Contact       *Contact         `json:"contact"`
+	ContactID     string           `json:"contact_id"`
+	FundAccountID string           `json:"fund_account_id"`
+	Amount        int64            `json:"amount"`
+	Currency      Currency         `json:"currency"`
+	Purpose       PayoutPurpose    `json:"purpose"`
+	Description   string           `json:"description"`
+	ReceiptID     string           `json:"receipt"`
+	Status        PayoutLinkStatus `json:"status"`
+	ShortURL      string           `json:"short_url"`
+	SendSMS       bool             `json:"send_sms"`
+	SendEmail     bool             `json:"send_email"`
+	ExpireBy      int64            `json:"expire_by"`
+	ExpiredAt     int64            `json:"expired_at"`
+	CancelledAt   int64            `json:"cancelled_at"`
+	Notes         Notes            `json:"notes"`
+	// IdempotencyKey is key which link was created with, set by CreateLink.
+	IdempotencyKey string `json:"-"`
+}
+
+// PayoutLinkStatus is status of payout link.
+type PayoutLinkStatus string
+
+// List of payout link statuses.
+const (
+	PayoutLinkStatusPending    PayoutLinkStatus = "pending"
+	PayoutLinkStatusIssued     PayoutLinkStatus = "issued"
+	PayoutLinkStatusProcessing PayoutLinkStatus = "processing"
+	PayoutLinkStatusProcessed  PayoutLinkStatus = "processed"
+	PayoutLinkStatusCancelled  PayoutLinkStatus = "cancelled"
+	PayoutLinkStatusRejected   PayoutLinkStatus = "rejected"
+	PayoutLinkStatusExpired    PayoutLinkStatus = "expired"
+)
+
+// PayoutLinkParams is list of params that can be used when creating payout
+// link. Contact is either existing one, by setting its ID, or is created with
+// link.
+type PayoutLinkParams struct {
+	Params
+	AccountNumber *string                  `json:"account_number,omitempty"`
+	Contact       *PayoutLinkContactParams `json:"contact,omitempty"`
+	Amount        *int64                   `json:"amount,omitempty"`
+	Currency      *Currency                `json:"currency,omitempty"`
+	Purpose       *PayoutPurpose           `json:"purpose,omitempty"`
+	Description   *string                  `json:"description,omitempty"`
+	ReceiptID     *string                  `json:"receipt,omitempty"`
+	SendSMS       *bool                    `json:"send_sms,omitempty"`
+	SendEmail     *bool                    `json:"send_email,omitempty"`
+	ExpireBy      *int64                   `json:"expire_by,omitempty"`
+	Notes         Notes                    `json:"notes,omitempty"`
+}
+
+// PayoutLinkContactParams is contact of payout link.
+type PayoutLinkContactParams struct {
+	ID      *string      `json:"id,omitempty"`
+	Name    *string      `json:"name,omitempty"`
+	Email   *string      `json:"email,omitempty"`
+	Contact *string      `json:"contact,omitempty"`
+	Type    *ContactType `json:"type,omitempty"`
+}
@@ -0,0 +1,166 @@
+package payout
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+)
+
+// IdempotencyHeader is header carrying idempotency key of payout, so that
+// retrying request does not make payout twice.
+const IdempotencyHeader = "X-Payout-Idempotency"
+
+// Client is used to access /payouts and /payout-links apis.
+type Client struct {
+	*razorpay.Client
+}
+
+// Create creates new payout, to existing fund account or to fund account
+// created along with it. If idempotency key is not set on params, it is
+// derived from params, and so ReferenceID is required to tell apart payouts
+// which are otherwise equal. Retrying with same params does not make payout
+// twice. Key is set on returned payout, even on error, so it can be persisted.
+func (c *Client) Create(ctx context.Context, params *razorpay.PayoutParams) (*razorpay.Payout, error) {
+	if params == nil {
+		params = &razorpay.PayoutParams{}
+	}
+
+	payout := &razorpay.Payout{}
+	if err := c.ValidateParams(params); err != nil {
+		return payout, err
+	}
+	// Params are copied, so that derived key is not reused when params are
+	// used as template for other payouts.
+	paramsCopy := *params
+	key, err := withIdempotencyKey(&paramsCopy.Params, params, "reference_id", params.ReferenceID)
+	if err != nil {
+		return payout, err
+	}
+	payout.IdempotencyKey = key
+	err = c.Call(ctx, http.MethodPost, "/payouts", &paramsCopy, payout)
+	return payout, err
+}
+
+// Get returns payout for id.
+func (c *Client) Get(ctx context.Context, id string) (*razorpay.Payout, error) {
+	payout := &razorpay.Payout{}
+	err := c.Call(ctx, http.MethodGet, "/payouts/"+id, nil, payout)
+	return payout, err
+}
+
+// List returns list of payouts for params. AccountNumber is required.
+func (c *Client) List(ctx context.Context, params *razorpay.PayoutListParams) (*razorpay.PayoutList, error) {
+	if params == nil {
+		params = &razorpay.PayoutListParams{}
+	}
+
+	payoutList := &razorpay.PayoutList{}
+	err := c.Call(ctx, http.MethodGet, "/payouts", params, payoutList)
+	return payoutList, err
+}
+
+// Cancel cancels payout, which is possible only while it is queued.
+func (c *Client) Cancel(ctx context.Context, id string) (*razorpay.Payout, error) {
+	payout := &razorpay.Payout{}
+	err := c.Call(ctx, http.MethodPost, "/payouts/"+id+"/cancel", nil, payout)
+	return payout, err
+}
+
+// CreateLink creates new payout link. Idempotency key is sent as in Create,
+// and is derived from params with ReceiptID required, if not set.
+func (c *Client) CreateLink(ctx context.Context, params *razorpay.PayoutLinkParams) (*razorpay.PayoutLink, error) {
+	if params == nil {
+		params = &razorpay.PayoutLinkParams{}
+	}
+
+	payoutLink := &razorpay.PayoutLink{}
+	if err := c.ValidateParams(params); err != nil {
+		return payoutLink, err
+	}
+	paramsCopy := *params
+	key, err := withIdempotencyKey(&paramsCopy.Params, params, "receipt", params.ReceiptID)
+	if err != nil {
+		return payoutLink, err
+	}
+	payoutLink.IdempotencyKey = key
+	err = c.Call(ctx, http.MethodPost, "/payout-links", &paramsCopy, payoutLink)
+	return payoutLink, err
+}
+
+// GetLink returns payout link for id.
+func (c *Client) GetLink(ctx context.Context, id string) (*razorpay.PayoutLink, error) {
+	payoutLink := &razorpay.PayoutLink{}
+	err := c.Call(ctx, http.MethodGet, "/payout-links/"+id, nil, payoutLink)
+	return payoutLink, err
+}
+
+// withIdempotencyKey replaces params with copy having idempotency key, unless
+// already set, and returns the key. Key is hash of body, which includes
+// reference, so that retry of same request sends same key. Headers are copied
+// as the map is shared with original.
+func withIdempotencyKey(params *razorpay.Params, body interface{}, referenceField string, reference *string) (string, error) {
+	if key := params.Headers()[IdempotencyHeader]; key != "" {
+		return key, nil
+	}
+	if reference == nil || *reference == "" {
+		return "", razorpay.ValidationErrors{{Field: referenceField, Description: referenceField + " is required when idempotency key is not set"}}
+	}
+	// Notes is a map and so is marshalled with sorted keys.
+	data, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	key := hex.EncodeToString(sum[:16])
+
+	headers := params.Headers()
+	*params = razorpay.Params{}
+	for name, value := range headers {
+		params.SetHeader(name, value)
+	}
+	params.SetHeader(IdempotencyHeader, key)
+	return key, nil
+}
+
+// Create creates new payout.
+func Create(ctx context.Context, params *razorpay.PayoutParams) (*razorpay.Payout, error) {
+	return getDefaultClient().Create(ctx, params)
+}
+
+// Get returns payout for id.
+func Get(ctx context.Context, id string) (*razorpay.Payout, error) {
+	return getDefaultClient().Get(ctx, id)
+}
+
+// List returns list of payouts for params.
+func List(ctx context.Context, params *razorpay.PayoutListParams) (*razorpay.PayoutList, error) {
+	return getDefaultClient().List(ctx, params)
+}
+
+// Cancel cancels payout, which is possible only while it is queued.
+func Cancel(ctx context.Context, id string) (*razorpay.Payout, error) {
+	return getDefaultClient().Cancel(ctx, id)
+}
+
+// CreateLink creates new payout link.
+func CreateLink(ctx context.Context, params *razorpay.PayoutLinkParams) (*razorpay.PayoutLink, error) {
+	return getDefaultClient().CreateLink(ctx, params)
+}
+
+// GetLink returns payout link for id.
+func GetLink(ctx context.Context, id string) (*razorpay.PayoutLink, error) {
+	return getDefaultClient().GetLink(ctx, id)
+}
+
+// NewClient returns new client.
+func NewClient(apiKey string, apiSecret string, apiBackend razorpay.Backend) *Client {
+	return &Client{razorpay.NewClient(apiKey, apiSecret, apiBackend)}
+}
+
+func getDefaultClient() *Client {
+	return &Client{razorpay.GetDefaultClient()}
+}
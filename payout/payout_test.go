@@ -0,0 +1,135 @@
+package payout
+
+import (
+	"context"
+	"testing"
+
+	faker "github.com/bxcodec/faker/v3"
+	razorpay "github.com/jitendra-1217/razorpay-go"
+	"github.com/jitendra-1217/razorpay-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	// accountNumber holds RazorpayX account number which payouts are made from.
+	accountNumber = "2323230041626905"
+	// payoutID holds new payout id created in Create test.
+	payoutID string
+)
+
+func newPayoutParams() *razorpay.PayoutParams {
+	return &razorpay.PayoutParams{
+		AccountNumber: &accountNumber,
+		FundAccount: &razorpay.PayoutFundAccountParams{
+			AccountType: razorpay.FundAccountTypeVPA.Ptr(),
+			VPA:         &razorpay.FundAccountVPAParams{Address: razorpay.String("gaurav.kumar@exampleupi")},
+			Contact:     &razorpay.ContactParams{Name: razorpay.String(faker.Name()), Type: razorpay.ContactTypeVendor.Ptr()},
+		},
+		Amount:            razorpay.Int64(100),
+		Currency:          razorpay.CurrencyINR.Ptr(),
+		Mode:              razorpay.PayoutModeUPI.Ptr(),
+		Purpose:           razorpay.PayoutPurposePayout.Ptr(),
+		QueueIfLowBalance: razorpay.Bool(true),
+		ReferenceID:       razorpay.String("vendor_bill_1"),
+	}
+}
+
+func TestClient_CreateIdempotencyKey(t *testing.T) {
+	keys := []string{}
+	client := NewClient("key", "secret", testutil.BackendFunc(func(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error {
+		assert.Equal(t, "v1/payouts", path)
+		keys = append(keys, params.Headers()[IdempotencyHeader])
+		return nil
+	}))
+
+	// Case: Reference id is required when key is not set.
+	params := newPayoutParams()
+	params.ReferenceID = nil
+	_, err := client.Create(context.Background(), params)
+	assert.EqualError(t, err, "field: reference_id, description: reference_id is required when idempotency key is not set")
+	assert.Empty(t, keys)
+
+	// Case: Key is derived from params, and so retry sends same key, whereas
+	// params used as template for other payout send other key. Params are not
+	// changed.
+	params = newPayoutParams()
+	payout, err := client.Create(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Len(t, payout.IdempotencyKey, 32)
+	_, err = client.Create(context.Background(), params)
+	assert.Nil(t, err)
+	params.Amount = razorpay.Int64(200)
+	_, err = client.Create(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{payout.IdempotencyKey, payout.IdempotencyKey}, keys[:2])
+	assert.NotEqual(t, keys[0], keys[2])
+	assert.Empty(t, params.Headers()[IdempotencyHeader])
+
+	// Case: Key set of own is sent as is, on every retry.
+	params = newPayoutParams()
+	params.ReferenceID = nil
+	params.SetHeader(IdempotencyHeader, "vendor_bill_1")
+	payout, err = client.Create(context.Background(), params)
+	assert.Nil(t, err)
+	_, err = client.Create(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, "vendor_bill_1", payout.IdempotencyKey)
+	assert.Equal(t, []string{"vendor_bill_1", "vendor_bill_1"}, keys[3:])
+}
+
+func TestClient_Create(t *testing.T) {
+	payout, err := Create(context.Background(), newPayoutParams())
+	// For use in later tests.
+	payoutID = payout.ID
+	assert.Nil(t, err)
+	assert.True(t, testutil.IsAnyID(payout.ID))
+	assert.Equal(t, int64(100), payout.Amount)
+	assert.Equal(t, razorpay.PayoutModeUPI, payout.Mode)
+	assert.True(t, payout.Status.IsValid())
+}
+
+func TestClient_Get(t *testing.T) {
+	payout, err := Get(context.Background(), payoutID)
+	assert.Nil(t, err)
+	assert.Equal(t, payoutID, payout.ID)
+}
+
+func TestClient_List(t *testing.T) {
+	_, err := List(context.Background(), &razorpay.PayoutListParams{AccountNumber: &accountNumber})
+	assert.Nil(t, err)
+}
+
+func TestClient_Cancel(t *testing.T) {
+	payout, err := Get(context.Background(), payoutID)
+	assert.Nil(t, err)
+	if !payout.IsCancellable() {
+		t.Skip("payout is not queued")
+	}
+	payout, err = Cancel(context.Background(), payoutID)
+	assert.Nil(t, err)
+	assert.Equal(t, razorpay.PayoutStatusCancelled, payout.Status)
+}
+
+func TestClient_CreateLink(t *testing.T) {
+	params := &razorpay.PayoutLinkParams{
+		AccountNumber: &accountNumber,
+		Contact: &razorpay.PayoutLinkContactParams{
+			Name:    razorpay.String(faker.Name()),
+			Contact: razorpay.String("9123456789"),
+			Type:    razorpay.ContactTypeCustomer.Ptr(),
+		},
+		Amount:      razorpay.Int64(1000),
+		Currency:    razorpay.CurrencyINR.Ptr(),
+		Purpose:     razorpay.PayoutPurposeRefund.Ptr(),
+		Description: razorpay.String("Refund of order"),
+		SendSMS:     razorpay.Bool(false),
+		SendEmail:   razorpay.Bool(false),
+		ReceiptID:   razorpay.String(faker.UUIDDigit()),
+	}
+	payoutLink, err := CreateLink(context.Background(), params)
+	assert.Nil(t, err)
+	assert.True(t, testutil.IsAnyID(payoutLink.ID))
+	payoutLink, err = GetLink(context.Background(), payoutLink.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1000), payoutLink.Amount)
+}
@@ -0,0 +1,33 @@
+package razorpay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayoutStatus_CanTransitionTo(t *testing.T) {
+	assert.True(t, PayoutStatusQueued.CanTransitionTo(PayoutStatusCancelled))
+	assert.True(t, PayoutStatusProcessing.CanTransitionTo(PayoutStatusProcessed))
+	assert.True(t, PayoutStatusProcessed.CanTransitionTo(PayoutStatusReversed))
+	assert.False(t, PayoutStatusProcessing.CanTransitionTo(PayoutStatusCancelled))
+	assert.False(t, PayoutStatusProcessed.CanTransitionTo(PayoutStatusFailed))
+	assert.False(t, PayoutStatus("unknown").CanTransitionTo(PayoutStatusProcessed))
+
+	assert.False(t, PayoutStatusProcessed.IsTerminal())
+	assert.True(t, PayoutStatusReversed.IsTerminal())
+	assert.True(t, PayoutStatusCancelled.IsTerminal())
+	assert.False(t, PayoutStatus("unknown").IsTerminal())
+	assert.Equal(t, []PayoutStatus{PayoutStatusReversed}, PayoutStatusProcessed.Next())
+}
+
+func TestPayoutMode_Supports(t *testing.T) {
+	assert.True(t, PayoutModeIMPS.Supports(FundAccountTypeBankAccount))
+	assert.True(t, PayoutModeIMPS.Supports(FundAccountTypeCard))
+	assert.True(t, PayoutModeNEFT.Supports(FundAccountTypeCard))
+	assert.False(t, PayoutModeRTGS.Supports(FundAccountTypeCard))
+	assert.True(t, PayoutModeUPI.Supports(FundAccountTypeVPA))
+	assert.False(t, PayoutModeUPI.Supports(FundAccountTypeBankAccount))
+	assert.Equal(t, []FundAccountType{FundAccountTypeCard}, PayoutModeCard.FundAccountTypes())
+	assert.False(t, PayoutMode("upi").IsValid())
+}
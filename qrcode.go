@@ -0,0 +1,115 @@
+package razorpay
+
+import (
+	"fmt"
+	"html"
+)
+
+// QRCode is a Razorpay entity representation.
+type QRCode struct {
+	Response
+	Entity
+	Name                   string       `json:"name"`
+	Usage                  QRCodeUsage  `json:"usage"`
+	Type                   QRCodeType   `json:"type"`
+	ImageURL               string       `json:"image_url"`
+	PaymentAmount          int64        `json:"payment_amount"`
+	Status                 QRCodeStatus `json:"status"`
+	Description            string       `json:"description"`
+	FixedAmount            bool         `json:"fixed_amount"`
+	PaymentsAmountReceived int64        `json:"payments_amount_received"`
+	PaymentsCountReceived  int64        `json:"payments_count_received"`
+	Notes                  Notes        `json:"notes"`
+	CustomerID             string       `json:"customer_id"`
+	CloseBy                int64        `json:"close_by"`
+	ClosedAt               int64        `json:"closed_at"`
+	CloseReason            string       `json:"close_reason"`
+}
+
+// ImageHTML returns html img tag rendering qr code image, for embedding in
+// web page or email.
+func (q *QRCode) ImageHTML(alt string) string {
+	return fmt.Sprintf(`<img src="%s" alt="%s">`, html.EscapeString(q.ImageURL), html.EscapeString(alt))
+}
+
+// QRCodeImage is metadata of downloaded qr code image.
+type QRCodeImage struct {
+	URL           string
+	ContentType   string
+	ContentLength int64
+}
+
+// QRCodeType is type of qr code.
+type QRCodeType string
+
+// List of qr code types.
+const (
+	QRCodeTypeUPI    QRCodeType = "upi_qr"
+	QRCodeTypeBharat QRCodeType = "bharat_qr"
+)
+
+// IsValid returns if type is one of known types.
+func (t QRCodeType) IsValid() bool {
+	return t == QRCodeTypeUPI || t == QRCodeTypeBharat
+}
+
+// Ptr returns pointer to type value.
+func (t QRCodeType) Ptr() *QRCodeType {
+	return &t
+}
+
+// QRCodeUsage is usage of qr code i.e. whether it accepts one or many payments.
+type QRCodeUsage string
+
+// List of qr code usages.
+const (
+	QRCodeUsageSingleUse   QRCodeUsage = "single_use"
+	QRCodeUsageMultipleUse QRCodeUsage = "multiple_use"
+)
+
+// IsValid returns if usage is one of known usages.
+func (u QRCodeUsage) IsValid() bool {
+	return u == QRCodeUsageSingleUse || u == QRCodeUsageMultipleUse
+}
+
+// Ptr returns pointer to usage value.
+func (u QRCodeUsage) Ptr() *QRCodeUsage {
+	return &u
+}
+
+// QRCodeStatus is status of qr code.
+type QRCodeStatus string
+
+// List of qr code statuses.
+const (
+	QRCodeStatusActive QRCodeStatus = "active"
+	QRCodeStatusClosed QRCodeStatus = "closed"
+)
+
+// QRCodeList is collection of qr codes.
+type QRCodeList struct {
+	Response
+	EntityList
+	QRCodes []*QRCode `json:"items"`
+}
+
+// QRCodeParams is list of params that can be used when creating qr code.
+type QRCodeParams struct {
+	Params
+	Type          *QRCodeType  `json:"type,omitempty"`
+	Name          *string      `json:"name,omitempty"`
+	Usage         *QRCodeUsage `json:"usage,omitempty"`
+	FixedAmount   *bool        `json:"fixed_amount,omitempty"`
+	PaymentAmount *int64       `json:"payment_amount,omitempty"`
+	Description   *string      `json:"description,omitempty"`
+	CustomerID    *string      `json:"customer_id,omitempty"`
+	CloseBy       *int64       `json:"close_by,omitempty"`
+	Notes         Notes        `json:"notes,omitempty"`
+}
+
+// QRCodeListParams is list params that can be used when listing qr codes.
+type QRCodeListParams struct {
+	ListParams
+	CustomerID *string `url:"customer_id,omitempty"`
+	PaymentID  *string `url:"payment_id,omitempty"`
+}
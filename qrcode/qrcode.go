@@ -0,0 +1,129 @@
+package qrcode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+)
+
+// Client is used to access /payments/qr_codes apis.
+type Client struct {
+	*razorpay.Client
+}
+
+// Create creates new qr code.
+func (c *Client) Create(ctx context.Context, params *razorpay.QRCodeParams) (*razorpay.QRCode, error) {
+	qrCode := &razorpay.QRCode{}
+	if err := c.ValidateParams(params); err != nil {
+		return qrCode, err
+	}
+	err := c.Call(ctx, http.MethodPost, "/payments/qr_codes", params, qrCode)
+	return qrCode, err
+}
+
+// Get returns qr code for id.
+func (c *Client) Get(ctx context.Context, id string, params *razorpay.GetParams) (*razorpay.QRCode, error) {
+	if params == nil {
+		params = &razorpay.GetParams{}
+	}
+
+	qrCode := &razorpay.QRCode{}
+	err := c.Call(ctx, http.MethodGet, "/payments/qr_codes/"+id, params, qrCode)
+	return qrCode, err
+}
+
+// List returns list of qr codes for params.
+func (c *Client) List(ctx context.Context, params *razorpay.QRCodeListParams) (*razorpay.QRCodeList, error) {
+	if params == nil {
+		params = &razorpay.QRCodeListParams{}
+	}
+
+	qrCodeList := &razorpay.QRCodeList{}
+	err := c.Call(ctx, http.MethodGet, "/payments/qr_codes", params, qrCodeList)
+	return qrCodeList, err
+}
+
+// Close closes qr code so that it does not accept payments anymore.
+func (c *Client) Close(ctx context.Context, id string) (*razorpay.QRCode, error) {
+	qrCode := &razorpay.QRCode{}
+	err := c.Call(ctx, http.MethodPost, "/payments/qr_codes/"+id+"/close", nil, qrCode)
+	return qrCode, err
+}
+
+// Payments returns list of payments made against qr code.
+func (c *Client) Payments(ctx context.Context, id string, params *razorpay.ListParams) (*razorpay.PaymentList, error) {
+	if params == nil {
+		params = &razorpay.ListParams{}
+	}
+
+	paymentList := &razorpay.PaymentList{}
+	err := c.Call(ctx, http.MethodGet, "/payments/qr_codes/"+id+"/payments", params, paymentList)
+	return paymentList, err
+}
+
+// DownloadImage writes qr code image to w, for example to print or to serve
+// from own host, and returns its metadata. Image is fetched using backend of
+// client, which must implement razorpay.Downloader.
+func (c *Client) DownloadImage(ctx context.Context, qrCode *razorpay.QRCode, w io.Writer) (*razorpay.QRCodeImage, error) {
+	if qrCode == nil || qrCode.ImageURL == "" {
+		return nil, errors.New("image url of qr code is required")
+	}
+	resp, err := c.Download(ctx, qrCode.ImageURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d downloading qr code image", resp.StatusCode)
+	}
+
+	n, err := io.Copy(w, resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	// Image url is usually a short url which redirects to actual image.
+	return &razorpay.QRCodeImage{URL: resp.Request.URL.String(), ContentType: resp.Header.Get("Content-Type"), ContentLength: n}, nil
+}
+
+// Create creates new qr code.
+func Create(ctx context.Context, params *razorpay.QRCodeParams) (*razorpay.QRCode, error) {
+	return getDefaultClient().Create(ctx, params)
+}
+
+// Get returns qr code for id.
+func Get(ctx context.Context, id string, params *razorpay.GetParams) (*razorpay.QRCode, error) {
+	return getDefaultClient().Get(ctx, id, params)
+}
+
+// List returns list of qr codes for params.
+func List(ctx context.Context, params *razorpay.QRCodeListParams) (*razorpay.QRCodeList, error) {
+	return getDefaultClient().List(ctx, params)
+}
+
+// Close closes qr code so that it does not accept payments anymore.
+func Close(ctx context.Context, id string) (*razorpay.QRCode, error) {
+	return getDefaultClient().Close(ctx, id)
+}
+
+// Payments returns list of payments made against qr code.
+func Payments(ctx context.Context, id string, params *razorpay.ListParams) (*razorpay.PaymentList, error) {
+	return getDefaultClient().Payments(ctx, id, params)
+}
+
+// DownloadImage writes qr code image to w and returns its metadata.
+func DownloadImage(ctx context.Context, qrCode *razorpay.QRCode, w io.Writer) (*razorpay.QRCodeImage, error) {
+	return getDefaultClient().DownloadImage(ctx, qrCode, w)
+}
+
+// NewClient returns new client.
+func NewClient(apiKey string, apiSecret string, apiBackend razorpay.Backend) *Client {
+	return &Client{razorpay.NewClient(apiKey, apiSecret, apiBackend)}
+}
+
+func getDefaultClient() *Client {
+	return &Client{razorpay.GetDefaultClient()}
+}
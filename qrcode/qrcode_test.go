@@ -0,0 +1,113 @@
+package qrcode
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	faker "github.com/bxcodec/faker/v3"
+	razorpay "github.com/jitendra-1217/razorpay-go"
+	"github.com/jitendra-1217/razorpay-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	// qrCode holds new qr code created in Create test.
+	qrCode *razorpay.QRCode
+)
+
+func TestClient_Create(t *testing.T) {
+	name := faker.Name()
+	params := &razorpay.QRCodeParams{
+		Type:          razorpay.QRCodeTypeUPI.Ptr(),
+		Name:          &name,
+		Usage:         razorpay.QRCodeUsageSingleUse.Ptr(),
+		FixedAmount:   razorpay.Bool(true),
+		PaymentAmount: razorpay.Int64(300),
+	}
+	var err error
+	qrCode, err = Create(context.Background(), params)
+	assert.Nil(t, err)
+	assert.True(t, testutil.IsAnyID(qrCode.ID))
+	assert.Equal(t, name, qrCode.Name)
+	assert.Equal(t, int64(300), qrCode.PaymentAmount)
+	assert.Equal(t, razorpay.QRCodeStatusActive, qrCode.Status)
+}
+
+func TestClient_Get(t *testing.T) {
+	fetchedQRCode, err := Get(context.Background(), qrCode.ID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, qrCode.ID, fetchedQRCode.ID)
+}
+
+func TestClient_List(t *testing.T) {
+	qrCodeList, err := List(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.True(t, qrCodeList.Count > 0)
+}
+
+func TestClient_Payments(t *testing.T) {
+	paymentList, err := Payments(context.Background(), qrCode.ID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), paymentList.Count)
+}
+
+func TestClient_DownloadImage(t *testing.T) {
+	w := &bytes.Buffer{}
+	image, err := DownloadImage(context.Background(), qrCode, w)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(w.Len()), image.ContentLength)
+	assert.Contains(t, image.ContentType, "image/")
+}
+
+// doerFunc implements razorpay.Doer.
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClient_DownloadImageBackend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "image/png")
+		w.Write([]byte("png"))
+	}))
+	defer server.Close()
+
+	// Case: Image is fetched using http client of backend.
+	calls := 0
+	doer := doerFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		return server.Client().Do(req)
+	})
+	client := NewClient("key", "secret", &razorpay.APIBackend{Host: server.URL, HTTPClient: doer})
+	w := &bytes.Buffer{}
+	image, err := client.DownloadImage(context.Background(), &razorpay.QRCode{ImageURL: server.URL + "/qr.png"}, w)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, "png", w.String())
+	assert.Equal(t, "image/png", image.ContentType)
+
+	// Case: Qr code without image url is not requested.
+	_, err = client.DownloadImage(context.Background(), nil, w)
+	assert.EqualError(t, err, "image url of qr code is required")
+	_, err = client.DownloadImage(context.Background(), &razorpay.QRCode{}, w)
+	assert.EqualError(t, err, "image url of qr code is required")
+	assert.Equal(t, 1, calls)
+
+	// Case: Backend not supporting download.
+	client = NewClient("key", "secret", testutil.BackendFunc(func(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error {
+		return nil
+	}))
+	_, err = client.DownloadImage(context.Background(), &razorpay.QRCode{ImageURL: server.URL + "/qr.png"}, w)
+	assert.NotNil(t, err)
+}
+
+func TestClient_Close(t *testing.T) {
+	closedQRCode, err := Close(context.Background(), qrCode.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, razorpay.QRCodeStatusClosed, closedQRCode.Status)
+}
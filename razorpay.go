@@ -5,15 +5,17 @@ import (
 	"context"
 	"crypto/hmac"
 	"crypto/sha256"
-	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
+	"reflect"
 	"sort"
+	"syscall"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -60,6 +62,10 @@ var (
 	// DefaultAPIBackend is if set will be used. It is helpful for unit
 	// testability of integration code.
 	DefaultAPIBackend Backend
+
+	// DefaultCredentialsProvider is if set will be used instead of APIKey and
+	// APISecret, for example to pick rotated credentials.
+	DefaultCredentialsProvider CredentialsProvider
 )
 
 // Doer interface has the method required to use a type as custom http client.
@@ -69,10 +75,11 @@ type Doer interface {
 
 // Client is a configured backend to access apis.
 type Client struct {
-	apiVersion string
-	apiKey     string
-	apiSecret  string
-	apiBackend Backend
+	apiVersion              string
+	authenticator           Authenticator
+	apiBackend              Backend
+	accountID               string
+	disableParamsValidation bool
 }
 
 // Call sets context and invokes' backend's call.
@@ -84,16 +91,66 @@ func (c *Client) Call(ctx context.Context, method string, path string, params Re
 	// Prefixes path with api version.
 	path = c.apiVersion + path
 
-	// Sets 'Authorization' header in `params`.
-	authorizationValue := "Basic " + base64.StdEncoding.EncodeToString([]byte(c.apiKey+":"+c.apiSecret))
-	params.SetHeader("Authorization", authorizationValue)
+	// Scopes request to sub-merchant account, if set.
+	if c.accountID != "" {
+		params.SetHeader("X-Razorpay-Account", c.accountID)
+	}
+
+	err := c.call(ctx, method, path, params, v)
+
+	// Refreshes credential and retries once when remote responds with 401 and
+	// authenticator supports refreshing e.g. OAuth access token, or cached
+	// credentials.
+	var razorpayErr *Error
+	if refresher, ok := c.authenticator.(Refresher); ok && errors.As(err, &razorpayErr) && razorpayErr.StatusCode == http.StatusUnauthorized {
+		if refreshErr := refresher.Refresh(ctx, params); refreshErr != nil {
+			if refreshErr == errNotRefreshable {
+				return err
+			}
+			return refreshErr
+		}
+		// Own body e.g. streamed file is consumed by first attempt, and so is
+		// sent again only if it can be rewound.
+		if _, ok := params.(BodyEncoder); ok {
+			if rewinder, ok := params.(BodyRewinder); !ok || rewinder.RewindBody() != nil {
+				return err
+			}
+		}
+		return c.call(ctx, method, path, params, v)
+	}
+
+	return err
+}
 
+// call authenticates params and invokes backend's call.
+func (c *Client) call(ctx context.Context, method string, path string, params RequestParams, v ResponseHolder) error {
+	if err := c.authenticator.Authenticate(ctx, params); err != nil {
+		return err
+	}
 	return c.apiBackend.Call(ctx, method, path, params, v)
 }
 
+// Download fetches file at absolute url e.g. qr code image, using backend of
+// client. Caller must close body of response.
+func (c *Client) Download(ctx context.Context, url string) (*http.Response, error) {
+	downloader, ok := c.apiBackend.(Downloader)
+	if !ok {
+		return nil, fmt.Errorf("backend %T does not support downloading", c.apiBackend)
+	}
+	return downloader.Download(ctx, url)
+}
+
+// WithAccount returns copy of client which scopes all requests to given
+// sub-merchant account i.e. sets 'X-Razorpay-Account' header.
+func (c *Client) WithAccount(accountID string) *Client {
+	clone := *c
+	clone.accountID = accountID
+	return &clone
+}
+
 // IsValidPaymentSignature returns if payment signature is valid.
 // Ref: https://razorpay.com/docs/payment-gateway/quick-integration/#step-4-verify-the-signature.
-func (c *Client) IsValidPaymentSignature(_ context.Context, params map[string]string) (bool, error) {
+func (c *Client) IsValidPaymentSignature(ctx context.Context, params map[string]string) (bool, error) {
 	// Sample value of params:
 	// {
 	//   "razorpay_signature": "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx",
@@ -121,21 +178,55 @@ func (c *Client) IsValidPaymentSignature(_ context.Context, params map[string]st
 		payload += params[k]
 	}
 
-	return isPayloadSignatureValid([]byte(payload), signature, c.apiSecret), nil
+	// Payment signature is computed using api secret and so it can only be
+	// verified when client uses basic authentication.
+	basicAuthenticator, ok := c.authenticator.(*BasicAuthenticator)
+	if !ok {
+		return false, fmt.Errorf("payment signature can only be verified with basic authentication")
+	}
+	credentials, err := basicAuthenticator.Provider.Credentials(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// Also accepts signature computed using previous secret, if any, so that
+	// verification keeps working during rotation window.
+	if isPayloadSignatureValid([]byte(payload), signature, credentials.APISecret) {
+		return true, nil
+	}
+	if credentials.PreviousAPISecret != "" {
+		return isPayloadSignatureValid([]byte(payload), signature, credentials.PreviousAPISecret), nil
+	}
+	return false, nil
 }
 
 // GetDefaultClient returns client configured with defaults.
 func GetDefaultClient() *Client {
+	if DefaultCredentialsProvider != nil {
+		return NewClientWithCredentialsProvider(DefaultCredentialsProvider, DefaultAPIBackend)
+	}
 	return NewClient(APIKey, APISecret, DefaultAPIBackend)
 }
 
 // NewClient returns new client.
 func NewClient(apiKey string, apiSecret string, apiBackend Backend) *Client {
+	return NewClientWithCredentialsProvider(&StaticCredentialsProvider{apiKey, apiSecret}, apiBackend)
+}
+
+// NewClientWithCredentialsProvider returns new client which consults given
+// provider for credentials on each request.
+func NewClientWithCredentialsProvider(provider CredentialsProvider, apiBackend Backend) *Client {
+	return NewClientWithAuthenticator(&BasicAuthenticator{provider}, apiBackend)
+}
+
+// NewClientWithAuthenticator returns new client which authenticates requests
+// using given authenticator.
+func NewClientWithAuthenticator(authenticator Authenticator, apiBackend Backend) *Client {
 	if apiBackend == nil {
 		apiBackend = &APIBackend{APIHost, HTTPClient}
 	}
 
-	return &Client{APIVersion, apiKey, apiSecret, apiBackend}
+	return &Client{APIVersion, authenticator, apiBackend, "", DisableParamsValidation}
 }
 
 // Backend provides Call function to make request to remote host.
@@ -144,14 +235,32 @@ type Backend interface {
 	Call(ctx context.Context, method string, path string, params RequestParams, v ResponseHolder) error
 }
 
+// Downloader is implemented by backends which can fetch file at absolute url
+// e.g. qr code image, using own transport. It is optional, so that existing
+// backends keep satisfying Backend.
+type Downloader interface {
+	Download(ctx context.Context, url string) (*http.Response, error)
+}
+
 // APIBackend implements Backend.
 type APIBackend struct {
 	Host       string
 	HTTPClient Doer
 }
 
+// Download makes GET request to absolute url using HTTPClient, without
+// credentials as url may be of other host.
+func (b *APIBackend) Download(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "jitendra-1217/razorpay-go/"+clientVersion)
+	return b.HTTPClient.Do(req)
+}
+
 // Call builds, make requests, and unmarshals resp body into holder.
-func (b *APIBackend) Call(_ context.Context, method string, path string, params RequestParams, v ResponseHolder) error {
+func (b *APIBackend) Call(ctx context.Context, method string, path string, params RequestParams, v ResponseHolder) error {
 	host := defaultBackendHost
 	if b.Host != "" {
 		host = b.Host
@@ -168,28 +277,41 @@ func (b *APIBackend) Call(_ context.Context, method string, path string, params
 		url = url + "?" + queryParams.Encode()
 	}
 
-	// Builds json body for non-GET requests.
-	var body io.Reader
+	// Builds json body for non-GET requests, unless params encode own body
+	// e.g. as form or multipart.
+	var requestBody *RequestBody
 	if !isMethodGet(method) {
-		jsonBody, err := json.Marshal(params)
+		var err error
+		if bodyEncoder, ok := params.(BodyEncoder); ok {
+			requestBody, err = bodyEncoder.EncodeBody()
+		} else {
+			requestBody, err = EncodeJSONBody(params)
+		}
 		if err != nil {
 			return err
 		}
-		body = bytes.NewBuffer(jsonBody)
 	}
 
-	req, err := http.NewRequest(method, url, body)
+	var body io.Reader
+	if requestBody != nil {
+		body = requestBody.Reader
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return err
 	}
+	// Sets content length when known, otherwise body is sent chunked.
+	if requestBody != nil && requestBody.ContentLength >= 0 {
+		req.ContentLength = requestBody.ContentLength
+	}
 
 	// Appends header params to request, if any set from client layer.
 	for k, v := range params.Headers() {
 		req.Header.Set(k, v)
 	}
 	// Appends rest of headers...
-	if !isMethodGet(method) {
-		req.Header.Set("Content-Type", "application/json")
+	if requestBody != nil {
+		req.Header.Set("Content-Type", requestBody.ContentType)
 	}
 	req.Header.Set("User-Agent", "jitendra-1217/razorpay-go/"+clientVersion)
 
@@ -207,27 +329,56 @@ func (b *APIBackend) Call(_ context.Context, method string, path string, params
 	// If resp is not success then unmarshals body into new error type and
 	// returns. This way it is uniform and forces to handle error responses.
 	if !isStatusCodeSuccess(resp.StatusCode) {
-		v := &struct{ Error *Error }{}
-		err := json.Unmarshal(respBody, v)
-		if err != nil {
-			return err
-		}
-		return v.Error
+		return newErrorFromResponse(resp.StatusCode, respBody)
 	}
 
 	if v == nil {
 		v = &Response{}
 	}
 	v.SetBody(respBody)
-	// Unmarshals resp body into holder.
-	err = json.Unmarshal(respBody, v)
+	// Unmarshals resp body into holder, tolerating inconsistent shapes. Values
+	// which could not be unmarshalled are set as warnings, if holder supports.
+	warnings, err := UnmarshalTolerant(respBody, v)
 	if err != nil {
 		return err
 	}
+	if w, ok := v.(interface{ AddWarnings([]*DecodeWarning) }); ok {
+		w.AddWarnings(warnings)
+	}
 
 	return nil
 }
 
+// newErrorFromResponse unmarshals error response body into Error type.
+func newErrorFromResponse(statusCode int, respBody []byte) error {
+	// Api host responds with error object i.e. `{"error": {"code": ...}}`,
+	// whereas auth host responds with error code i.e. `{"error": "invalid_grant",
+	// "error_description": ...}`. Both are handled here.
+	v := &struct {
+		Error            json.RawMessage `json:"error"`
+		ErrorDescription string          `json:"error_description"`
+	}{}
+	err := json.Unmarshal(respBody, v)
+	if err != nil {
+		return err
+	}
+
+	e := &Error{}
+	if len(v.Error) > 0 && v.Error[0] == '{' {
+		err := json.Unmarshal(v.Error, e)
+		if err != nil {
+			return err
+		}
+	} else if len(v.Error) > 0 && v.Error[0] == '"' {
+		_ = json.Unmarshal(v.Error, &e.Code)
+		e.Description = v.ErrorDescription
+	}
+	e.StatusCode = statusCode
+	e.SetBody(respBody)
+
+	return e
+}
+
 func isMethodGet(method string) bool {
 	return method == http.MethodGet
 }
@@ -264,20 +415,20 @@ type Notes map[string]string
 // UnmarshalJSON unmarshals raw notes into Notes type.
 func (n *Notes) UnmarshalJSON(data []byte) error {
 	// In json response, notes will appear slice i.e. `[]` when it is empty,
-	// and so only attempts unmarshal when it appears object.
-	if len(data) > 0 && data[0] == '{' {
-		// Also it is possible that notes is not truly map[string]string, for
-		// those case it will simply return error, for now.
-		alias := map[string]string{}
-		err := json.Unmarshal(data, &alias)
-		if err != nil {
-			return err
-		}
-		*n = alias
+	// and values need not be string. Those are tolerated and values are
+	// coerced to string.
+	var alias map[string]string
+	if _, err := UnmarshalTolerant(data, &alias); err != nil {
+		return err
 	}
+	*n = alias
 	return nil
 }
 
+func (n *Notes) tolerantShape() reflect.Type {
+	return reflect.TypeOf(map[string]string{})
+}
+
 // ListParams is common list params that can be used when listing entities.
 type ListParams struct {
 	Params
@@ -288,6 +439,11 @@ type ListParams struct {
 	Expand []string `url:"expand[],omitempty"`
 }
 
+// SetDateRange sets from and to, to list entities created in the range.
+func (p *ListParams) SetDateRange(from time.Time, to time.Time) {
+	p.From, p.To = Int64(from.Unix()), Int64(to.Unix())
+}
+
 // GetParams is common params that can be used when getting entities.
 type GetParams struct {
 	Params
@@ -315,6 +471,10 @@ func (p *Params) SetHeader(key string, value string) {
 // Response is common part of response.
 type Response struct {
 	Body []byte
+
+	// Warnings are values in response which did not match type of the field,
+	// and were skipped or coerced instead of failing whole response.
+	Warnings []*DecodeWarning `json:"-"`
 }
 
 // SetBody sets raw response body.
@@ -322,9 +482,15 @@ func (r *Response) SetBody(body []byte) {
 	r.Body = body
 }
 
+// AddWarnings adds warnings of unmarshalling response body.
+func (r *Response) AddWarnings(warnings []*DecodeWarning) {
+	r.Warnings = append(r.Warnings, warnings...)
+}
+
 // Error represents an error response.
 type Error struct {
 	Response
+	StatusCode  int               `json:"-"`
 	Code        string            `json:"code"`
 	Description string            `json:"description"`
 	Field       string            `json:"field"`
@@ -339,6 +505,33 @@ func (e *Error) Error() string {
 	return fmt.Sprintf("code: %s, description: %s", e.Code, e.Description)
 }
 
+// IsTemporary returns if request may succeed on retry i.e. remote is rate
+// limiting or has failed.
+func (e *Error) IsTemporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// IsTemporaryError returns if err is temporary and so request may succeed on
+// retry i.e. remote is rate limiting or has failed, request timed out, or
+// connection broke midway. Other errors e.g. invalid request, validation,
+// decoding or credentials errors are not temporary.
+func IsTemporaryError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var razorpayErr *Error
+	if errors.As(err, &razorpayErr) {
+		return razorpayErr.IsTemporary()
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET)
+}
+
 // IsValidPaymentSignature returns if payment signature is valid.
 // Ref: https://razorpay.com/docs/payment-gateway/quick-integration/#step-4-verify-the-signature.
 func IsValidPaymentSignature(ctx context.Context, params map[string]string) (bool, error) {
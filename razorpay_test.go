@@ -3,9 +3,15 @@ package razorpay
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
+	"syscall"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -65,3 +71,17 @@ func TestIsValidWebhookRequest(t *testing.T) {
 	assert.False(t, isValid)
 	assert.Nil(t, err)
 }
+
+func TestIsTemporaryError(t *testing.T) {
+	assert.False(t, IsTemporaryError(nil))
+	assert.False(t, IsTemporaryError(&Error{StatusCode: http.StatusBadRequest}))
+	assert.True(t, IsTemporaryError(&Error{StatusCode: http.StatusTooManyRequests}))
+	assert.True(t, IsTemporaryError(fmt.Errorf("wrapped: %w", &Error{StatusCode: http.StatusServiceUnavailable})))
+	assert.False(t, IsTemporaryError(ValidationErrors{{"amount", "amount is required"}}))
+	assert.False(t, IsTemporaryError(context.Canceled))
+	assert.True(t, IsTemporaryError(context.DeadlineExceeded))
+	assert.True(t, IsTemporaryError(&url.Error{Op: "Get", URL: "https://api.razorpay.com", Err: io.ErrUnexpectedEOF}))
+	assert.True(t, IsTemporaryError(&net.OpError{Op: "read", Err: syscall.ECONNRESET}))
+	assert.False(t, IsTemporaryError(&json.SyntaxError{}))
+	assert.False(t, IsTemporaryError(errors.New("bearer token is empty")))
+}
@@ -4,15 +4,105 @@ package razorpay
 type Refund struct {
 	Response
 	Entity
-	Amount         int64             `json:"amount"`
-	Currency       string            `json:"currency"`
-	PaymentId      string            `json:"payment_id"`
-	Receipt        string            `json:"receipt"`
-	AcquirerData   map[string]string `json:"acquirer_data"`
-	Status         string            `json:"status"`
-	SpeedProcessed string            `json:"speed_processed"`
-	SpeedRequested string            `json:"speed_requested"`
-	Notes          Notes             `json:"notes"`
+	Amount         int64              `json:"amount"`
+	Currency       Currency           `json:"currency"`
+	PaymentId      string             `json:"payment_id"`
+	Receipt        string             `json:"receipt"`
+	AcquirerData   RefundAcquirerData `json:"acquirer_data"`
+	Status         RefundStatus       `json:"status"`
+	SpeedProcessed RefundSpeed        `json:"speed_processed"`
+	SpeedRequested RefundSpeed        `json:"speed_requested"`
+	Notes          Notes              `json:"notes"`
+	BatchID        string             `json:"batch_id"`
+}
+
+// IsSpeedFulfilled returns if refund is processed at requested speed. Refund
+// requested at optimum speed is fulfilled only if processed instantly, else it
+// falls back to normal speed.
+func (r *Refund) IsSpeedFulfilled() bool {
+	if r.Status != RefundStatusProcessed {
+		return false
+	}
+	switch r.SpeedRequested {
+	case RefundSpeedOptimum, RefundSpeedInstant:
+		return r.SpeedProcessed == RefundSpeedInstant
+	}
+	return true
+}
+
+// RefundAcquirerData is reference of refund at bank, which customer can use to
+// track refund with bank. Set only once refund is processed.
+type RefundAcquirerData struct {
+	// ARN is acquirer reference number, for card refunds.
+	ARN string `json:"arn"`
+	// RRN is retrieval reference number, for upi refunds.
+	RRN string `json:"rrn"`
+	// UTR is unique transaction reference, for netbanking and bank transfer
+	// refunds.
+	UTR string `json:"utr"`
+}
+
+// Reference returns whichever of ARN, RRN or UTR is set.
+func (d RefundAcquirerData) Reference() string {
+	switch {
+	case d.ARN != "":
+		return d.ARN
+	case d.RRN != "":
+		return d.RRN
+	}
+	return d.UTR
+}
+
+// RefundStatus is status of refund. Unknown values are retained as is when
+// unmarshalling, and so IsValid can be used to detect them.
+type RefundStatus string
+
+// List of refund statuses.
+const (
+	RefundStatusPending   RefundStatus = "pending"
+	RefundStatusProcessed RefundStatus = "processed"
+	RefundStatusFailed    RefundStatus = "failed"
+)
+
+// IsValid returns if status is one of known statuses.
+func (s RefundStatus) IsValid() bool {
+	switch s {
+	case RefundStatusPending, RefundStatusProcessed, RefundStatusFailed:
+		return true
+	}
+	return false
+}
+
+// IsTerminal returns if refund is processed or failed, after which status
+// does not change.
+func (s RefundStatus) IsTerminal() bool {
+	return s == RefundStatusProcessed || s == RefundStatusFailed
+}
+
+// RefundSpeed is speed at which refund is requested or processed. Unknown
+// values are retained as is when unmarshalling, and so IsValid can be used to
+// detect them.
+type RefundSpeed string
+
+// List of refund speeds.
+const (
+	RefundSpeedNormal  RefundSpeed = "normal"
+	RefundSpeedOptimum RefundSpeed = "optimum"
+	RefundSpeedInstant RefundSpeed = "instant"
+)
+
+// IsValid returns if speed is one of known speeds.
+func (s RefundSpeed) IsValid() bool {
+	switch s {
+	case RefundSpeedNormal, RefundSpeedOptimum, RefundSpeedInstant:
+		return true
+	}
+	return false
+}
+
+// Ptr returns pointer to speed value.
+func (s RefundSpeed) Ptr() *RefundSpeed {
+	return &s
 }
 
 // RefundList is collection of refunds.
@@ -25,15 +115,23 @@ type RefundList struct {
 // RefundCreateParams is list of params that can be used when creating refund.
 type RefundCreateParams struct {
 	Params
-	Amount  *int64  `json:"amount,omitempty"`
-	Receipt *string `json:"receipt,omitempty"`
-	Speed   *string `json:"speed,omitempty"`
-	Notes   Notes   `json:"notes,omitempty"`
+	Amount  *int64       `json:"amount,omitempty"`
+	Receipt *string      `json:"receipt,omitempty"`
+	Speed   *RefundSpeed `json:"speed,omitempty"`
+	Notes   Notes        `json:"notes,omitempty"`
+}
+
+// SetAmount sets amount from money. Refund is always in currency of payment.
+func (p *RefundCreateParams) SetAmount(m Money) {
+	p.Amount = Int64(m.Amount)
 }
 
 // RefundListParams is list of params that can be used when listing refunds.
 type RefundListParams struct {
 	ListParams
+
+	// PaymentID if set lists only refunds of the payment.
+	PaymentID *string `url:"-"`
 }
 
 // RefundUpdateParams is list of params that can be used when updating refund.
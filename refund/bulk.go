@@ -0,0 +1,380 @@
+package refund
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"sync"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+	"github.com/jitendra-1217/razorpay-go/payment"
+)
+
+// IdempotencyHeader is header carrying idempotency key of refund, so that
+// retrying request does not refund payment twice.
+const IdempotencyHeader = "X-Refund-Idempotency"
+
+// List of bulk result statuses.
+const (
+	BulkStatusSucceeded = "succeeded"
+	BulkStatusFailed    = "failed"
+	BulkStatusSkipped   = "skipped"
+)
+
+// BulkInput is a refund to be created in bulk.
+type BulkInput struct {
+	PaymentID string
+	// Amount is amount to refund, or full amount of payment if nil.
+	Amount  *int64
+	Speed   *razorpay.RefundSpeed
+	Receipt *string
+	Notes   razorpay.Notes
+
+	// Line is position of input in source e.g. line number in csv, starting
+	// from 1. If zero, it is set by Run to order in which input is received,
+	// and so source must be sent in same order when resuming.
+	Line int
+
+	// IdempotencyKey if empty is derived from Line and rest of the fields, so
+	// that same input is refunded once across runs, while identical inputs on
+	// separate lines e.g. two equal partial refunds are refunded each.
+	IdempotencyKey string
+}
+
+// idempotencyKey returns key of input.
+func (i *BulkInput) idempotencyKey() string {
+	if i.IdempotencyKey != "" {
+		return i.IdempotencyKey
+	}
+	// Notes is a map and so is marshalled with sorted keys.
+	key, _ := json.Marshal([]interface{}{i.Line, i.PaymentID, i.Amount, i.Speed, i.Receipt, i.Notes})
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:16])
+}
+
+// BulkResult is result of a refund created in bulk.
+type BulkResult struct {
+	PaymentID        string `json:"payment_id"`
+	IdempotencyKey   string `json:"idempotency_key"`
+	Status           string `json:"status"`
+	RefundID         string `json:"refund_id,omitempty"`
+	RefundStatus     string `json:"refund_status,omitempty"`
+	Amount           int64  `json:"amount,omitempty"`
+	ErrorCode        string `json:"error_code,omitempty"`
+	ErrorDescription string `json:"error_description,omitempty"`
+
+	// Temporary is if failure may succeed on retry, in which case input is not
+	// checkpointed and is retried on resume.
+	Temporary bool `json:"temporary,omitempty"`
+}
+
+// BulkSummary is count of results of bulk run.
+type BulkSummary struct {
+	Total     int
+	Succeeded int
+	Failed    int
+	Skipped   int
+}
+
+// BulkReportWriter writes results of bulk run e.g. as csv or jsonl.
+type BulkReportWriter interface {
+	Write(result *BulkResult) error
+	Flush() error
+}
+
+// Bulk creates refunds in bulk using payment.Client.CreateRefund, with bounded
+// concurrency. Each refund is sent with idempotency key, and completed inputs
+// are recorded in checkpoint file so that run can be resumed after a crash.
+type Bulk struct {
+	Client *payment.Client
+	// Concurrency is maximum number of refunds created at once, defaults to 1.
+	Concurrency int
+	// CheckpointPath is jsonl file recording completed inputs, if set. Inputs
+	// found in it are skipped.
+	CheckpointPath string
+	// Report if set is written with result of each input.
+	Report BulkReportWriter
+
+	mu         sync.Mutex
+	checkpoint *os.File
+	done       map[string]bool
+	summary    BulkSummary
+}
+
+// NewBulk returns new bulk refund. Default client is used if nil.
+func NewBulk(client *payment.Client, concurrency int) *Bulk {
+	if client == nil {
+		client = &payment.Client{Client: razorpay.GetDefaultClient()}
+	}
+	return &Bulk{Client: client, Concurrency: concurrency}
+}
+
+// Run creates refunds for inputs until channel is closed. It stops taking
+// inputs when context is done, and returns error of context along with
+// summary so far. It returns error if checkpoint or report can not be
+// written, as resume would not be safe then. Once stopped, remaining inputs
+// are drained and dropped in background so that producer is not blocked, and
+// so producer must still close channel.
+func (b *Bulk) Run(ctx context.Context, inputs <-chan *BulkInput) (*BulkSummary, error) {
+	// Summary is of this run only, e.g. when run is resumed on same bulk.
+	b.summary = BulkSummary{}
+	if err := b.openCheckpoint(); err != nil {
+		return nil, err
+	}
+	defer b.closeCheckpoint()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	concurrency := b.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	// Dispatches inputs in order received, numbering them, and keeps draining
+	// inputs once stopped.
+	dispatched := make(chan *BulkInput)
+	go func() {
+		defer close(dispatched)
+		line := 0
+		for input := range inputs {
+			line++
+			if ctx.Err() != nil {
+				continue
+			}
+			if input.Line == 0 {
+				numbered := *input
+				numbered.Line = line
+				input = &numbered
+			}
+			select {
+			case dispatched <- input:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var runErr error
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				var input *BulkInput
+				var ok bool
+				select {
+				case <-ctx.Done():
+					return
+				case input, ok = <-dispatched:
+					if !ok {
+						return
+					}
+				}
+				if err := b.process(ctx, input); err != nil {
+					errOnce.Do(func() { runErr = err })
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	summary := b.summary
+	if runErr == nil && b.Report != nil {
+		runErr = b.Report.Flush()
+	}
+	if runErr == nil {
+		runErr = ctx.Err()
+	}
+	return &summary, runErr
+}
+
+// process creates refund for input and records result.
+func (b *Bulk) process(ctx context.Context, input *BulkInput) error {
+	key := input.idempotencyKey()
+	result := &BulkResult{PaymentID: input.PaymentID, IdempotencyKey: key}
+
+	b.mu.Lock()
+	skip := b.done[key]
+	b.mu.Unlock()
+
+	if skip {
+		result.Status = BulkStatusSkipped
+		return b.record(result)
+	}
+
+	params := &razorpay.RefundCreateParams{Amount: input.Amount, Speed: input.Speed, Receipt: input.Receipt, Notes: input.Notes}
+	params.SetHeader(IdempotencyHeader, key)
+	refund, err := b.Client.CreateRefund(ctx, input.PaymentID, params)
+	if err != nil {
+		// Run is being stopped, and so input is left for resume.
+		if ctx.Err() != nil {
+			return nil
+		}
+		result.Status = BulkStatusFailed
+		result.ErrorDescription = err.Error()
+		result.Temporary = razorpay.IsTemporaryError(err)
+		var razorpayErr *razorpay.Error
+		if errors.As(err, &razorpayErr) {
+			result.ErrorCode, result.ErrorDescription = razorpayErr.Code, razorpayErr.Description
+		}
+	} else {
+		result.Status = BulkStatusSucceeded
+		result.RefundID, result.RefundStatus, result.Amount = refund.ID, string(refund.Status), refund.Amount
+	}
+	return b.record(result)
+}
+
+// record writes result to report and checkpoint, and counts it in summary.
+func (b *Bulk) record(result *BulkResult) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.summary.Total++
+	switch result.Status {
+	case BulkStatusSucceeded:
+		b.summary.Succeeded++
+	case BulkStatusFailed:
+		b.summary.Failed++
+	case BulkStatusSkipped:
+		b.summary.Skipped++
+	}
+
+	if b.Report != nil {
+		if err := b.Report.Write(result); err != nil {
+			return err
+		}
+	}
+	if result.Status == BulkStatusSkipped || result.Temporary {
+		return nil
+	}
+	b.done[result.IdempotencyKey] = true
+	if b.checkpoint == nil {
+		return nil
+	}
+	line, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	if _, err := b.checkpoint.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return b.checkpoint.Sync()
+}
+
+// openCheckpoint loads completed inputs from checkpoint file, and opens it for
+// appending.
+func (b *Bulk) openCheckpoint() error {
+	b.done = map[string]bool{}
+	if b.CheckpointPath == "" {
+		return nil
+	}
+
+	f, err := os.OpenFile(b.CheckpointPath, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	content, err := ioutil.ReadAll(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	for _, line := range bytes.Split(content, []byte("\n")) {
+		result := &BulkResult{}
+		// Skips partially written last line, in case of crash.
+		if err := json.Unmarshal(line, result); err != nil || result.IdempotencyKey == "" {
+			continue
+		}
+		b.done[result.IdempotencyKey] = true
+	}
+	// Terminates partially written last line, so that it is not merged with
+	// next appended line.
+	if len(content) > 0 && content[len(content)-1] != '\n' {
+		if _, err := f.Write([]byte("\n")); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	b.checkpoint = f
+	return nil
+}
+
+func (b *Bulk) closeCheckpoint() {
+	if b.checkpoint != nil {
+		b.checkpoint.Close()
+		b.checkpoint = nil
+	}
+}
+
+// csvReportWriter writes results as csv, with header.
+type csvReportWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+// NewCSVReportWriter returns report writer writing results as csv.
+func NewCSVReportWriter(w io.Writer) BulkReportWriter {
+	return &csvReportWriter{w: csv.NewWriter(w)}
+}
+
+func (r *csvReportWriter) Write(result *BulkResult) error {
+	if !r.headerWritten {
+		header := []string{"payment_id", "idempotency_key", "status", "refund_id", "refund_status", "amount", "error_code", "error_description", "temporary"}
+		if err := r.w.Write(header); err != nil {
+			return err
+		}
+		r.headerWritten = true
+	}
+	return r.w.Write([]string{
+		result.PaymentID,
+		result.IdempotencyKey,
+		result.Status,
+		result.RefundID,
+		result.RefundStatus,
+		strconv.FormatInt(result.Amount, 10),
+		result.ErrorCode,
+		result.ErrorDescription,
+		strconv.FormatBool(result.Temporary),
+	})
+}
+
+func (r *csvReportWriter) Flush() error {
+	r.w.Flush()
+	return r.w.Error()
+}
+
+// jsonlReportWriter writes results as json lines.
+type jsonlReportWriter struct {
+	w *bufio.Writer
+}
+
+// NewJSONLReportWriter returns report writer writing results as json lines.
+func NewJSONLReportWriter(w io.Writer) BulkReportWriter {
+	return &jsonlReportWriter{bufio.NewWriter(w)}
+}
+
+func (r *jsonlReportWriter) Write(result *BulkResult) error {
+	line, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	_, err = r.w.Write(append(line, '\n'))
+	return err
+}
+
+func (r *jsonlReportWriter) Flush() error {
+	return r.w.Flush()
+}
@@ -0,0 +1,159 @@
+package refund
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+	"github.com/jitendra-1217/razorpay-go/payment"
+	"github.com/jitendra-1217/razorpay-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulk_Run(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bulk")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	checkpointPath := filepath.Join(dir, "checkpoint.jsonl")
+
+	var mu sync.Mutex
+	keys := map[string]string{}
+	var inFlight, maxInFlight int32
+	backend := testutil.BackendFunc(func(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+
+		paymentID := strings.Split(path, "/")[2]
+		mu.Lock()
+		keys[paymentID] = params.Headers()[IdempotencyHeader]
+		mu.Unlock()
+		switch paymentID {
+		case "pay_00000000000002":
+			return &razorpay.Error{StatusCode: http.StatusBadRequest, Code: "BAD_REQUEST_ERROR", Description: "The payment has been fully refunded already"}
+		case "pay_00000000000003":
+			return &razorpay.Error{StatusCode: http.StatusBadGateway, Code: "SERVER_ERROR", Description: "The server encountered an error"}
+		}
+		refund := v.(*razorpay.Refund)
+		refund.ID, refund.Status, refund.Amount = "rfnd_"+paymentID[4:], razorpay.RefundStatusProcessed, 100
+		return nil
+	})
+	inputs := func(ids ...string) <-chan *BulkInput {
+		ch := make(chan *BulkInput, len(ids))
+		for _, id := range ids {
+			ch <- &BulkInput{PaymentID: id, Amount: razorpay.Int64(100)}
+		}
+		close(ch)
+		return ch
+	}
+
+	// Case: First run with success, permanent and temporary failures.
+	report := &bytes.Buffer{}
+	bulk := NewBulk(payment.NewClient("key", "secret", backend), 2)
+	bulk.CheckpointPath = checkpointPath
+	bulk.Report = NewCSVReportWriter(report)
+	ids := []string{"pay_00000000000001", "pay_00000000000002", "pay_00000000000003", "pay_00000000000004", "pay_00000000000005"}
+	summary, err := bulk.Run(context.Background(), inputs(ids...))
+	assert.Nil(t, err)
+	assert.Equal(t, &BulkSummary{Total: 5, Succeeded: 3, Failed: 2}, summary)
+	assert.True(t, maxInFlight <= 2)
+	assert.Len(t, keys, 5)
+	assert.Equal(t, (&BulkInput{Line: 1, PaymentID: "pay_00000000000001", Amount: razorpay.Int64(100)}).idempotencyKey(), keys["pay_00000000000001"])
+
+	rows, err := csv.NewReader(report).ReadAll()
+	assert.Nil(t, err)
+	assert.Len(t, rows, 6)
+	errorCodes := map[string]string{}
+	for _, row := range rows[1:] {
+		errorCodes[row[0]] = row[6]
+	}
+	assert.Equal(t, "BAD_REQUEST_ERROR", errorCodes["pay_00000000000002"])
+	assert.Equal(t, "SERVER_ERROR", errorCodes["pay_00000000000003"])
+
+	// Case: Resume after crash, with partially written checkpoint line. Only
+	// temporary failure and new input are sent again.
+	f, _ := os.OpenFile(checkpointPath, os.O_APPEND|os.O_WRONLY, 0600)
+	f.Write([]byte(`{"payment_id":"pay_0000`)) //nolint
+	f.Close()
+	keys = map[string]string{}
+	report = &bytes.Buffer{}
+	bulk = NewBulk(payment.NewClient("key", "secret", backend), 2)
+	bulk.CheckpointPath = checkpointPath
+	bulk.Report = NewJSONLReportWriter(report)
+	summary, err = bulk.Run(context.Background(), inputs(append(ids, "pay_00000000000006")...))
+	assert.Nil(t, err)
+	assert.Equal(t, &BulkSummary{Total: 6, Succeeded: 1, Failed: 1, Skipped: 4}, summary)
+	assert.Len(t, keys, 2)
+	assert.Contains(t, keys, "pay_00000000000003")
+	assert.Contains(t, keys, "pay_00000000000006")
+	assert.Equal(t, 6, strings.Count(report.String(), "\n"))
+
+	// Case: Checkpoint still loads after terminating partial line, and summary
+	// of run again on same bulk is not added to previous.
+	summary, err = bulk.Run(context.Background(), inputs(append(ids, "pay_00000000000006")...))
+	assert.Nil(t, err)
+	assert.Equal(t, &BulkSummary{Total: 6, Failed: 1, Skipped: 5}, summary)
+}
+
+func TestBulk_RunIdenticalInputs(t *testing.T) {
+	var mu sync.Mutex
+	keys := map[string]bool{}
+	backend := testutil.BackendFunc(func(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error {
+		mu.Lock()
+		keys[params.Headers()[IdempotencyHeader]] = true
+		mu.Unlock()
+		return nil
+	})
+
+	// Case: Two equal partial refunds of payment are both made.
+	inputs := make(chan *BulkInput, 2)
+	inputs <- &BulkInput{PaymentID: "pay_00000000000001", Amount: razorpay.Int64(100)}
+	inputs <- &BulkInput{PaymentID: "pay_00000000000001", Amount: razorpay.Int64(100)}
+	close(inputs)
+	summary, err := NewBulk(payment.NewClient("key", "secret", backend), 2).Run(context.Background(), inputs)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, summary.Succeeded)
+	assert.Len(t, keys, 2)
+}
+
+func TestBulk_RunStopped(t *testing.T) {
+	backend := testutil.BackendFunc(func(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error {
+		return nil
+	})
+
+	// Case: Producer is not blocked once run is stopped.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	inputs := make(chan *BulkInput)
+	_, err := NewBulk(payment.NewClient("key", "secret", backend), 1).Run(ctx, inputs)
+	assert.Equal(t, context.Canceled, err)
+	sent := make(chan struct{})
+	go func() {
+		for i := 0; i < 10; i++ {
+			inputs <- &BulkInput{PaymentID: "pay_00000000000001"}
+		}
+		close(inputs)
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(time.Second):
+		t.Fatal("producer is blocked")
+	}
+}
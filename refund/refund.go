@@ -30,14 +30,19 @@ func (c *Client) Get(ctx context.Context, id string, params *razorpay.GetParams)
 	return refund, err
 }
 
-// List returns list of refunds for params.
+// List returns list of refunds for params. Refunds of a payment are listed if
+// params has payment id.
 func (c *Client) List(ctx context.Context, params *razorpay.RefundListParams) (*razorpay.RefundList, error) {
 	if params == nil {
 		params = &razorpay.RefundListParams{}
 	}
 
+	path := "/refunds"
+	if params.PaymentID != nil {
+		path = "/payments/" + *params.PaymentID + "/refunds"
+	}
 	refundList := &razorpay.RefundList{}
-	err := c.Call(ctx, http.MethodGet, "/refunds", params, refundList)
+	err := c.Call(ctx, http.MethodGet, path, params, refundList)
 	return refundList, err
 }
 
@@ -39,3 +39,11 @@ func TestClient_List(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, refundList.Count > 0)
 }
+
+func TestClient_List_Payment(t *testing.T) {
+	params := &razorpay.RefundListParams{PaymentID: razorpay.String("pay_FtZSrSlgxsJKiQ")}
+	refundList, err := List(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), refundList.Count)
+	assert.Equal(t, refundID, refundList.Refunds[0].ID)
+}
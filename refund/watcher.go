@@ -0,0 +1,126 @@
+package refund
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+)
+
+// Watcher tracks refunds until they are processed or failed. It polls refund
+// with backoff, and is woken early when refund is delivered from webhook. It
+// is safe for concurrent use.
+type Watcher struct {
+	Client  *Client
+	Backoff *razorpay.Backoff
+
+	mu      sync.Mutex
+	waiters map[string][]chan *razorpay.Refund
+}
+
+// NewWatcher returns new watcher. Default client and razorpay.DefaultBackoff
+// are used if nil.
+func NewWatcher(client *Client, backoff *razorpay.Backoff) *Watcher {
+	if client == nil {
+		client = getDefaultClient()
+	}
+	if backoff == nil {
+		backoff = razorpay.DefaultBackoff
+	}
+	return &Watcher{Client: client, Backoff: backoff}
+}
+
+// Wait waits until refund is processed or failed, and returns it. Temporary
+// errors of fetching refund are retried, see razorpay.IsTemporaryError. It
+// returns permanent error, or error of context if it is done before, along
+// with last fetched refund.
+func (w *Watcher) Wait(ctx context.Context, id string) (*razorpay.Refund, error) {
+	delivered := w.subscribe(id)
+	defer w.unsubscribe(id, delivered)
+
+	refund := &razorpay.Refund{}
+	for attempt := 0; ; attempt++ {
+		fetched, err := w.Client.Get(ctx, id, nil)
+		if err != nil && !razorpay.IsTemporaryError(err) {
+			return refund, err
+		}
+		if err == nil {
+			refund = fetched
+			if refund.Status.IsTerminal() {
+				return refund, nil
+			}
+		}
+
+		timer := time.NewTimer(w.Backoff.Duration(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return refund, ctx.Err()
+		case deliveredRefund := <-delivered:
+			timer.Stop()
+			if deliveredRefund.Status.IsTerminal() {
+				return deliveredRefund, nil
+			}
+		case <-timer.C:
+		}
+	}
+}
+
+// Deliver delivers refund received from webhook e.g. of refund.processed and
+// refund.failed events, to waiters of the refund.
+func (w *Watcher) Deliver(refund *razorpay.Refund) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, delivered := range w.waiters[refund.ID] {
+		// Drops delivery if waiter is already notified and is yet to receive.
+		select {
+		case delivered <- refund:
+		default:
+		}
+	}
+}
+
+// DeliverWebhook parses webhook request body and delivers refund of event, if
+// any. Request should be validated first using razorpay.IsValidWebhookRequest.
+func (w *Watcher) DeliverWebhook(body []byte) error {
+	event, err := razorpay.ParseWebhookEvent(body)
+	if err != nil {
+		return err
+	}
+	if refund, ok := event.Refund(); ok {
+		w.Deliver(refund)
+	}
+	return nil
+}
+
+func (w *Watcher) subscribe(id string) chan *razorpay.Refund {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.waiters == nil {
+		w.waiters = map[string][]chan *razorpay.Refund{}
+	}
+	delivered := make(chan *razorpay.Refund, 1)
+	w.waiters[id] = append(w.waiters[id], delivered)
+	return delivered
+}
+
+func (w *Watcher) unsubscribe(id string, delivered chan *razorpay.Refund) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	waiters := w.waiters[id]
+	for i, waiter := range waiters {
+		if waiter == delivered {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(waiters) == 0 {
+		delete(w.waiters, id)
+	} else {
+		w.waiters[id] = waiters
+	}
+}
@@ -0,0 +1,107 @@
+package refund
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	razorpay "github.com/jitendra-1217/razorpay-go"
+	"github.com/jitendra-1217/razorpay-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// pendingRefundBackend returns pending refund until calls reach processedAt.
+func pendingRefundBackend(calls *int, processedAt int) testutil.BackendFunc {
+	return func(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error {
+		*calls++
+		refund := v.(*razorpay.Refund)
+		refund.ID = "rfnd_00000000000001"
+		refund.Status = razorpay.RefundStatusPending
+		if *calls == processedAt {
+			refund.Status = razorpay.RefundStatusProcessed
+		}
+		return nil
+	}
+}
+
+func TestWatcher_Wait(t *testing.T) {
+	// Case: Polls until processed.
+	calls := 0
+	client := NewClient("key", "secret", pendingRefundBackend(&calls, 3))
+	watcher := NewWatcher(client, &razorpay.Backoff{Initial: time.Millisecond, Multiplier: 1})
+	refund, err := watcher.Wait(context.Background(), "rfnd_00000000000001")
+	assert.Nil(t, err)
+	assert.Equal(t, razorpay.RefundStatusProcessed, refund.Status)
+	assert.Equal(t, 3, calls)
+
+	// Case: Temporary error is retried, and permanent error is returned.
+	calls = 0
+	errs := map[int]error{
+		1: &razorpay.Error{StatusCode: http.StatusServiceUnavailable, Code: "SERVER_ERROR"},
+		2: &razorpay.Error{StatusCode: http.StatusTooManyRequests, Code: "BAD_REQUEST_ERROR"},
+	}
+	processed := pendingRefundBackend(&calls, 4)
+	watcher = NewWatcher(NewClient("key", "secret", testutil.BackendFunc(func(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error {
+		if err := errs[calls+1]; err != nil {
+			calls++
+			return err
+		}
+		return processed(ctx, method, path, params, v)
+	})), &razorpay.Backoff{Initial: time.Millisecond, Multiplier: 1})
+	refund, err = watcher.Wait(context.Background(), "rfnd_00000000000001")
+	assert.Nil(t, err)
+	assert.Equal(t, razorpay.RefundStatusProcessed, refund.Status)
+	assert.Equal(t, 4, calls)
+	calls = 0
+	errs = map[int]error{2: &razorpay.Error{StatusCode: http.StatusBadRequest, Code: "BAD_REQUEST_ERROR"}}
+	refund, err = watcher.Wait(context.Background(), "rfnd_00000000000001")
+	assert.Equal(t, errs[2], err)
+	assert.Equal(t, razorpay.RefundStatusPending, refund.Status)
+	calls = 0
+	errs = map[int]error{2: &json.SyntaxError{}}
+	_, err = watcher.Wait(context.Background(), "rfnd_00000000000001")
+	assert.Equal(t, errs[2], err)
+	assert.Equal(t, 2, calls)
+
+	// Case: Credentials provider error is returned.
+	calls = 0
+	provider := &razorpay.EnvCredentialsProvider{APIKeyEnv: "TEST_UNSET_KEY_ID", APISecretEnv: "TEST_UNSET_KEY_SECRET"}
+	watcher = NewWatcher(&Client{razorpay.NewClientWithCredentialsProvider(provider, pendingRefundBackend(&calls, 3))}, &razorpay.Backoff{Initial: time.Millisecond, Multiplier: 1})
+	_, err = watcher.Wait(context.Background(), "rfnd_00000000000001")
+	assert.EqualError(t, err, "TEST_UNSET_KEY_ID or TEST_UNSET_KEY_SECRET env is not set")
+	assert.Equal(t, 0, calls)
+
+	// Case: Woken by webhook before next poll.
+	calls = 0
+	watcher = NewWatcher(client, &razorpay.Backoff{Initial: time.Hour, Multiplier: 1})
+	go func() {
+		for {
+			time.Sleep(time.Millisecond)
+			watcher.mu.Lock()
+			subscribed := len(watcher.waiters) > 0
+			watcher.mu.Unlock()
+			if subscribed {
+				break
+			}
+		}
+		err := watcher.DeliverWebhook([]byte(`{"entity": "event", "event": "refund.processed", "payload": {"refund": {"entity": {
+			"id": "rfnd_00000000000001", "status": "processed", "acquirer_data": {"arn": "10000000000000"}
+		}}}}`))
+		assert.Nil(t, err)
+	}()
+	refund, err = watcher.Wait(context.Background(), "rfnd_00000000000001")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, "10000000000000", refund.AcquirerData.ARN)
+	assert.Empty(t, watcher.waiters)
+
+	// Case: Context is done.
+	calls = 0
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	refund, err = watcher.Wait(ctx, "rfnd_00000000000001")
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, razorpay.RefundStatusPending, refund.Status)
+}
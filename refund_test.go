@@ -0,0 +1,32 @@
+package razorpay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefund_IsSpeedFulfilled(t *testing.T) {
+	refund := &Refund{Status: RefundStatusProcessed, SpeedRequested: RefundSpeedOptimum, SpeedProcessed: RefundSpeedInstant}
+	assert.True(t, refund.IsSpeedFulfilled())
+	refund.SpeedProcessed = RefundSpeedNormal
+	assert.False(t, refund.IsSpeedFulfilled())
+	refund.SpeedRequested = RefundSpeedNormal
+	assert.True(t, refund.IsSpeedFulfilled())
+	refund.Status = RefundStatusPending
+	assert.False(t, refund.IsSpeedFulfilled())
+}
+
+func TestRefundAcquirerData_Reference(t *testing.T) {
+	refund := &Refund{}
+	_, err := UnmarshalTolerant([]byte(`{"acquirer_data": {"rrn": "123456789012"}}`), refund)
+	assert.Nil(t, err)
+	assert.Equal(t, "123456789012", refund.AcquirerData.Reference())
+
+	// Case: Not yet processed refund has empty acquirer data as list.
+	refund = &Refund{}
+	warnings, err := UnmarshalTolerant([]byte(`{"acquirer_data": []}`), refund)
+	assert.Nil(t, err)
+	assert.Empty(t, warnings)
+	assert.Equal(t, "", refund.AcquirerData.Reference())
+}
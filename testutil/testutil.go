@@ -1,6 +1,7 @@
 package testutil
 
 import (
+	"context"
 	"os"
 	"regexp"
 
@@ -21,3 +22,12 @@ var idRegex = regexp.MustCompile(`^[a-z]{0,10}_[\w]{14}$`)
 func IsAnyID(id string) bool {
 	return idRegex.MatchString(id)
 }
+
+// BackendFunc implements razorpay.Backend using a function, for tests not
+// hitting remote.
+type BackendFunc func(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error
+
+// Call calls f.
+func (f BackendFunc) Call(ctx context.Context, method string, path string, params razorpay.RequestParams, v razorpay.ResponseHolder) error {
+	return f(ctx, method, path, params, v)
+}
@@ -0,0 +1,67 @@
+package razorpay
+
+// Token is a Razorpay entity representation of saved card, emandate or other
+// recurring payment instrument of customer.
+type Token struct {
+	Response
+	Entity
+	Token            string                 `json:"token"`
+	Bank             string                 `json:"bank"`
+	Wallet           string                 `json:"wallet"`
+	Method           PaymentMethod          `json:"method"`
+	Card             *Card                  `json:"card"`
+	Recurring        bool                   `json:"recurring"`
+	RecurringDetails *TokenRecurringDetails `json:"recurring_details"`
+	AuthType         TokenAuthType          `json:"auth_type"`
+	MRN              string                 `json:"mrn"`
+	MaxAmount        int64                  `json:"max_amount"`
+	UsedAt           int64                  `json:"used_at"`
+	ExpiredAt        int64                  `json:"expired_at"`
+	DCC              bool                   `json:"dcc_enabled"`
+	Notes            Notes                  `json:"notes"`
+}
+
+// TokenRecurringDetails is status of token for recurring payments.
+type TokenRecurringDetails struct {
+	Status        string `json:"status"`
+	FailureReason string `json:"failure_reason"`
+}
+
+// TokenAuthType is type of authentication used when registering emandate or
+// card token for recurring payments.
+type TokenAuthType string
+
+// List of token auth types.
+const (
+	TokenAuthTypeNetbanking TokenAuthType = "netbanking"
+	TokenAuthTypeDebitCard  TokenAuthType = "debitcard"
+	TokenAuthTypeAadhaar    TokenAuthType = "aadhaar"
+	TokenAuthTypePhysical   TokenAuthType = "physical"
+)
+
+// IsValid returns if auth type is one of known auth types.
+func (a TokenAuthType) IsValid() bool {
+	switch a {
+	case TokenAuthTypeNetbanking, TokenAuthTypeDebitCard, TokenAuthTypeAadhaar, TokenAuthTypePhysical:
+		return true
+	}
+	return false
+}
+
+// Ptr returns pointer to auth type value.
+func (a TokenAuthType) Ptr() *TokenAuthType {
+	return &a
+}
+
+// TokenList is collection of tokens.
+type TokenList struct {
+	Response
+	EntityList
+	Tokens []*Token `json:"items"`
+}
+
+// TokenDeleteResponse is response of deleting token.
+type TokenDeleteResponse struct {
+	Response
+	Deleted bool `json:"deleted"`
+}
@@ -0,0 +1,562 @@
+package razorpay
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// minAmount is minimum amount in minor unit accepted by remote e.g. INR 1.00.
+	minAmount = 100
+
+	// maxReceiptLength is maximum length of receipt.
+	maxReceiptLength = 40
+
+	// maxNotesCount is maximum number of notes key-value pairs.
+	maxNotesCount = 15
+
+	// maxNotesValueLength is maximum length of each notes value.
+	maxNotesValueLength = 256
+)
+
+var (
+	// DisableParamsValidation if set disables client side validation of params
+	// for clients created thereafter.
+	DisableParamsValidation bool
+
+	emailRegex   = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+	contactRegex = regexp.MustCompile(`^\+?[0-9]{8,15}$`)
+	gstinRegex   = regexp.MustCompile(`^[0-9]{2}[A-Z0-9]{13}$`)
+
+	cardNumberRegex        = regexp.MustCompile(`^[0-9]{12,19}$`)
+	cardExpMonthRegex      = regexp.MustCompile(`^(0?[1-9]|1[0-2])$`)
+	cardExpYearRegex       = regexp.MustCompile(`^([0-9]{2}|[0-9]{4})$`)
+	cardCVVRegex           = regexp.MustCompile(`^[0-9]{3,4}$`)
+	narrationRegex         = regexp.MustCompile(`^[A-Za-z0-9 ]*$`)
+	ifscRegex              = regexp.MustCompile(`^[A-Z]{4}0[A-Z0-9]{6}$`)
+	bankAccountNumberRegex = regexp.MustCompile(`^[A-Za-z0-9]{5,35}$`)
+	vpaRegex               = regexp.MustCompile(`^[a-zA-Z0-9.\-_]{2,256}@[a-zA-Z]{2,64}$`)
+)
+
+// Validator is implemented by params that can be validated on client side
+// before sending, saving a network round-trip.
+type Validator interface {
+	Validate() error
+}
+
+// ValidationError is field level error of params, similar to Error.Field.
+type ValidationError struct {
+	Field       string
+	Description string
+}
+
+// Error returns one-liner error string.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("field: %s, description: %s", e.Field, e.Description)
+}
+
+// ValidationErrors is list of field level errors of params.
+type ValidationErrors []*ValidationError
+
+// Error returns one-liner error string.
+func (e ValidationErrors) Error() string {
+	errs := make([]string, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err.Error())
+	}
+	return strings.Join(errs, "; ")
+}
+
+// ValidateParams validates params, unless disabled for client.
+func (c *Client) ValidateParams(params Validator) error {
+	if c.disableParamsValidation || params == nil {
+		return nil
+	}
+	return params.Validate()
+}
+
+// WithParamsValidation returns copy of client with params validation enabled
+// or disabled.
+func (c *Client) WithParamsValidation(enabled bool) *Client {
+	clone := *c
+	clone.disableParamsValidation = !enabled
+	return &clone
+}
+
+// Validate validates notes i.e. count and length of values.
+func (n Notes) Validate() error {
+	v := &validation{}
+	v.notes("notes", n)
+	return v.err()
+}
+
+// Validate validates params used when creating order.
+func (p *OrderParams) Validate() error {
+	if p == nil {
+		p = &OrderParams{}
+	}
+	v := &validation{}
+	// Registration order of emandate and nach is authorised for zero amount.
+	min := int64(minAmount)
+	if p.Method != nil && (*p.Method == PaymentMethodEmandate || *p.Method == PaymentMethodNach) {
+		min = 0
+	}
+	v.amount("amount", p.Amount, min)
+	v.currency("currency", p.Currency, true)
+	v.maxLength("receipt", p.Receipt, maxReceiptLength)
+	v.notes("notes", p.Notes)
+	if p.Method != nil && !p.Method.IsValid() {
+		v.add("method", "method %q is invalid", *p.Method)
+	}
+	if p.FirstPaymentMinAmount != nil {
+		if p.PartialPayment == nil || !*p.PartialPayment {
+			v.add("first_payment_min_amount", "first_payment_min_amount is allowed only when partial_payment is true")
+		} else if *p.FirstPaymentMinAmount < minAmount || (p.Amount != nil && *p.FirstPaymentMinAmount > *p.Amount) {
+			v.add("first_payment_min_amount", "first_payment_min_amount must be between %d and amount", minAmount)
+		}
+	}
+	if p.Discount != nil && *p.Discount && len(p.Offers) == 0 {
+		v.add("discount", "discount is allowed only with offers")
+	}
+	if p.Token != nil {
+		if p.CustomerID == nil {
+			v.add("customer_id", "customer_id is required with token")
+		}
+		if p.Method == nil {
+			v.add("method", "method is required with token")
+		}
+		if p.Token.MaxAmount != nil && *p.Token.MaxAmount < minAmount {
+			v.add("token.max_amount", "token.max_amount must be at least %d", minAmount)
+		}
+		if p.Token.ExpireAt != nil && *p.Token.ExpireAt <= time.Now().Unix() {
+			v.add("token.expire_at", "token.expire_at must be in future")
+		}
+		if p.Token.AuthType != nil && !p.Token.AuthType.IsValid() {
+			v.add("token.auth_type", "token.auth_type %q is invalid", *p.Token.AuthType)
+		}
+		v.notes("token.notes", p.Token.Notes)
+	}
+	return v.err()
+}
+
+// Validate validates params used when capturing payment.
+func (p *PaymentCaptureParams) Validate() error {
+	if p == nil {
+		p = &PaymentCaptureParams{}
+	}
+	v := &validation{}
+	v.amount("amount", p.Amount, 1)
+	v.currency("currency", p.Currency, true)
+	return v.err()
+}
+
+// Validate validates params used when creating payment server to server.
+func (p *PaymentCreateParams) Validate() error {
+	if p == nil {
+		p = &PaymentCreateParams{}
+	}
+	v := &validation{}
+	v.amount("amount", p.Amount, minAmount)
+	v.currency("currency", p.Currency, true)
+	v.required("email", p.Email)
+	v.secretFormat("email", p.Email, emailRegex)
+	v.required("contact", p.Contact)
+	v.secretFormat("contact", p.Contact, contactRegex)
+	v.required("ip", p.IP)
+	v.required("user_agent", p.UserAgent)
+	v.notes("notes", p.Notes)
+
+	method := PaymentMethod("")
+	if p.Method != nil {
+		method = *p.Method
+	}
+	switch method {
+	case PaymentMethodCard:
+		// Saved card is charged using token, needing only cvv.
+		card := p.Card
+		if card == nil {
+			card = &PaymentCardParams{}
+		}
+		if p.Token == nil {
+			v.required("card.number", card.Number)
+			v.secretFormat("card.number", card.Number, cardNumberRegex)
+			v.required("card.expiry_month", card.ExpiryMonth)
+			v.secretFormat("card.expiry_month", card.ExpiryMonth, cardExpMonthRegex)
+			v.required("card.expiry_year", card.ExpiryYear)
+			v.secretFormat("card.expiry_year", card.ExpiryYear, cardExpYearRegex)
+		}
+		v.secretFormat("card.cvv", card.CVV, cardCVVRegex)
+	case PaymentMethodNetbanking:
+		v.required("bank", p.Bank)
+	case PaymentMethodWallet:
+		v.required("wallet", p.Wallet)
+	case PaymentMethodUPI:
+		upi := p.UPI
+		if upi == nil {
+			upi = &PaymentUPIParams{}
+		}
+		if upi.Flow != nil && !upi.Flow.IsValid() {
+			v.add("upi.flow", "upi.flow %q is invalid", *upi.Flow)
+		} else if upi.Flow == nil || *upi.Flow == PaymentUPIFlowCollect {
+			v.required("upi.vpa", upi.VPA)
+			v.secretFormat("upi.vpa", upi.VPA, vpaRegex)
+		}
+	case "":
+		v.add("method", "method is required")
+	default:
+		v.add("method", "method %q is not supported", method)
+	}
+	return v.err()
+}
+
+// Validate validates params used when creating recurring payment.
+func (p *PaymentRecurringParams) Validate() error {
+	if p == nil {
+		p = &PaymentRecurringParams{}
+	}
+	v := &validation{}
+	v.amount("amount", p.Amount, minAmount)
+	v.currency("currency", p.Currency, true)
+	v.required("order_id", p.OrderID)
+	v.required("customer_id", p.CustomerID)
+	v.required("token", p.Token)
+	v.secretFormat("email", p.Email, emailRegex)
+	v.secretFormat("contact", p.Contact, contactRegex)
+	v.notes("notes", p.Notes)
+	return v.err()
+}
+
+// Validate validates params used when creating refund.
+func (p *RefundCreateParams) Validate() error {
+	if p == nil {
+		p = &RefundCreateParams{}
+	}
+	v := &validation{}
+	if p.Amount != nil {
+		v.amount("amount", p.Amount, minAmount)
+	}
+	if p.Speed != nil && !p.Speed.IsValid() {
+		v.add("speed", "speed %q is invalid", *p.Speed)
+	}
+	v.maxLength("receipt", p.Receipt, maxReceiptLength)
+	v.notes("notes", p.Notes)
+	return v.err()
+}
+
+// Validate validates params used when creating or updating customer.
+func (p *CustomerParams) Validate() error {
+	if p == nil {
+		p = &CustomerParams{}
+	}
+	v := &validation{}
+	p.validate(v, "")
+	return v.err()
+}
+
+func (p *CustomerParams) validate(v *validation, prefix string) {
+	v.maxLength(prefix+"name", p.Name, 50)
+	v.secretFormat(prefix+"email", p.Email, emailRegex)
+	v.secretFormat(prefix+"contact", p.Contact, contactRegex)
+	v.format(prefix+"gstin", p.Gstin, gstinRegex)
+	v.notes(prefix+"notes", p.Notes)
+	if p.FailExisting != nil && *p.FailExisting != "0" && *p.FailExisting != "1" {
+		v.add(prefix+"fail_existing", "%sfail_existing must be 0 or 1", prefix)
+	}
+}
+
+// Validate validates params used when creating payment link.
+func (p *PaymentLinkParams) Validate() error {
+	if p == nil {
+		p = &PaymentLinkParams{}
+	}
+	v := &validation{}
+	v.amount("amount", p.Amount, minAmount)
+	v.currency("currency", p.Currency, false)
+	if p.FirstMinPartialAmount != nil {
+		if p.AcceptPartial == nil || !*p.AcceptPartial {
+			v.add("first_min_partial_amount", "first_min_partial_amount is allowed only when accept_partial is true")
+		} else if *p.FirstMinPartialAmount < minAmount || (p.Amount != nil && *p.FirstMinPartialAmount > *p.Amount) {
+			v.add("first_min_partial_amount", "first_min_partial_amount must be between %d and amount", minAmount)
+		}
+	}
+	v.maxLength("description", p.Description, 2048)
+	v.maxLength("reference_id", p.ReferenceID, maxReceiptLength)
+	if p.ExpireBy != nil && *p.ExpireBy <= time.Now().Unix() {
+		v.add("expire_by", "expire_by must be in future")
+	}
+	if p.Customer != nil {
+		p.Customer.validate(v, "customer.")
+	}
+	// UPI payment links are only in INR and do not support partial payments.
+	if p.UPILink != nil && *p.UPILink {
+		if p.Currency != nil && *p.Currency != CurrencyINR {
+			v.add("currency", "currency must be INR for upi link")
+		}
+		if p.AcceptPartial != nil && *p.AcceptPartial {
+			v.add("accept_partial", "accept_partial is not supported for upi link")
+		}
+	}
+	v.notes("notes", p.Notes)
+	return v.err()
+}
+
+// Validate validates params used when creating qr code.
+func (p *QRCodeParams) Validate() error {
+	if p == nil {
+		p = &QRCodeParams{}
+	}
+	v := &validation{}
+	if p.Type == nil || !p.Type.IsValid() {
+		v.add("type", "type must be one of upi_qr or bharat_qr")
+	}
+	if p.Usage == nil || !p.Usage.IsValid() {
+		v.add("usage", "usage must be one of single_use or multiple_use")
+	}
+	// Fixed amount qr code accepts only payment amount, and so it is required.
+	if p.FixedAmount != nil && *p.FixedAmount {
+		v.amount("payment_amount", p.PaymentAmount, minAmount)
+	} else if p.PaymentAmount != nil {
+		v.add("payment_amount", "payment_amount is allowed only when fixed_amount is true")
+	}
+	if p.CloseBy != nil && *p.CloseBy <= time.Now().Unix() {
+		v.add("close_by", "close_by must be in future")
+	}
+	v.notes("notes", p.Notes)
+	return v.err()
+}
+
+// Validate validates params used when creating or updating contact.
+func (p *ContactParams) Validate() error {
+	if p == nil {
+		p = &ContactParams{}
+	}
+	v := &validation{}
+	p.validate(v, "")
+	return v.err()
+}
+
+func (p *ContactParams) validate(v *validation, prefix string) {
+	v.maxLength(prefix+"name", p.Name, 50)
+	v.secretFormat(prefix+"email", p.Email, emailRegex)
+	v.secretFormat(prefix+"contact", p.Contact, contactRegex)
+	v.maxLength(prefix+"reference_id", p.ReferenceID, maxReceiptLength)
+	v.notes(prefix+"notes", p.Notes)
+}
+
+// Validate validates params used when creating fund account.
+func (p *FundAccountParams) Validate() error {
+	if p == nil {
+		p = &FundAccountParams{}
+	}
+	v := &validation{}
+	v.required("contact_id", p.ContactID)
+	v.fundAccount("", p.AccountType, p.BankAccount, p.VPA, p.Card)
+	return v.err()
+}
+
+// Validate validates params used when validating fund account.
+func (p *FundAccountValidationParams) Validate() error {
+	if p == nil {
+		p = &FundAccountValidationParams{}
+	}
+	v := &validation{}
+	v.required("account_number", p.AccountNumber)
+	if p.FundAccount == nil {
+		v.add("fund_account.id", "fund_account.id is required")
+	} else {
+		v.required("fund_account.id", p.FundAccount.ID)
+	}
+	if p.Amount != nil {
+		v.amount("amount", p.Amount, 100)
+	}
+	v.currency("currency", p.Currency, false)
+	v.notes("notes", p.Notes)
+	return v.err()
+}
+
+// Validate validates params used when creating payout.
+func (p *PayoutParams) Validate() error {
+	if p == nil {
+		p = &PayoutParams{}
+	}
+	v := &validation{}
+	v.required("account_number", p.AccountNumber)
+	v.amount("amount", p.Amount, minAmount)
+	v.currency("currency", p.Currency, true)
+	if p.Mode == nil {
+		v.add("mode", "mode is required")
+	} else if !p.Mode.IsValid() {
+		v.add("mode", "mode %q is not supported", *p.Mode)
+	}
+	if p.Purpose == nil || *p.Purpose == "" {
+		v.add("purpose", "purpose is required")
+	}
+	switch {
+	case p.FundAccountID != nil && p.FundAccount != nil:
+		v.add("fund_account", "only one of fund_account_id and fund_account can be set")
+	case p.FundAccount != nil:
+		fundAccount := p.FundAccount
+		v.fundAccount("fund_account.", fundAccount.AccountType, fundAccount.BankAccount, fundAccount.VPA, fundAccount.Card)
+		if p.Mode != nil && p.Mode.IsValid() && fundAccount.AccountType != nil && fundAccount.AccountType.IsValid() && !p.Mode.Supports(*fundAccount.AccountType) {
+			v.add("mode", "mode %q is not supported for account_type %q", *p.Mode, *fundAccount.AccountType)
+		}
+		if fundAccount.Contact == nil {
+			v.add("fund_account.contact.name", "fund_account.contact.name is required")
+		} else {
+			v.required("fund_account.contact.name", fundAccount.Contact.Name)
+			fundAccount.Contact.validate(v, "fund_account.contact.")
+		}
+	default:
+		v.required("fund_account_id", p.FundAccountID)
+	}
+	v.maxLength("reference_id", p.ReferenceID, maxReceiptLength)
+	v.maxLength("narration", p.Narration, 30)
+	v.format("narration", p.Narration, narrationRegex)
+	v.notes("notes", p.Notes)
+	return v.err()
+}
+
+// Validate validates params used when creating payout link.
+func (p *PayoutLinkParams) Validate() error {
+	if p == nil {
+		p = &PayoutLinkParams{}
+	}
+	v := &validation{}
+	v.required("account_number", p.AccountNumber)
+	v.amount("amount", p.Amount, minAmount)
+	v.currency("currency", p.Currency, true)
+	if p.Purpose == nil || *p.Purpose == "" {
+		v.add("purpose", "purpose is required")
+	}
+	if p.Contact == nil {
+		v.add("contact", "contact is required")
+	} else if p.Contact.ID == nil {
+		v.required("contact.name", p.Contact.Name)
+		v.secretFormat("contact.email", p.Contact.Email, emailRegex)
+		v.secretFormat("contact.contact", p.Contact.Contact, contactRegex)
+	}
+	v.maxLength("receipt", p.ReceiptID, maxReceiptLength)
+	if p.ExpireBy != nil && *p.ExpireBy <= time.Now().Unix() {
+		v.add("expire_by", "expire_by must be in future")
+	}
+	v.notes("notes", p.Notes)
+	return v.err()
+}
+
+// validation accumulates field level errors.
+type validation struct {
+	errs ValidationErrors
+}
+
+func (v *validation) add(field string, format string, args ...interface{}) {
+	v.errs = append(v.errs, &ValidationError{field, fmt.Sprintf(format, args...)})
+}
+
+// err returns accumulated errors, or nil if there is none.
+func (v *validation) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+func (v *validation) amount(field string, value *int64, min int64) {
+	if value == nil {
+		v.add(field, "%s is required", field)
+	} else if *value < min {
+		v.add(field, "%s must be at least %d", field, min)
+	}
+}
+
+func (v *validation) currency(field string, value *Currency, required bool) {
+	if value == nil {
+		if required {
+			v.add(field, "%s is required", field)
+		}
+	} else if !value.IsValid() {
+		v.add(field, "%s %q is not supported", field, *value)
+	}
+}
+
+func (v *validation) required(field string, value *string) {
+	if value == nil || *value == "" {
+		v.add(field, "%s is required", field)
+	}
+}
+
+func (v *validation) maxLength(field string, value *string, max int) {
+	if value != nil && utf8.RuneCountInString(*value) > max {
+		v.add(field, "%s must be at most %d characters", field, max)
+	}
+}
+
+func (v *validation) format(field string, value *string, regex *regexp.Regexp) {
+	if value != nil && !regex.MatchString(*value) {
+		v.add(field, "%s %q is invalid", field, *value)
+	}
+}
+
+// secretFormat is format for sensitive fields e.g. card number, or personal
+// data e.g. email, whose value is not put in error as errors end up in logs.
+func (v *validation) secretFormat(field string, value *string, regex *regexp.Regexp) {
+	if value != nil && !regex.MatchString(*value) {
+		v.add(field, "%s is invalid", field)
+	}
+}
+
+// fundAccount validates fields of fund account as per its type, prefixing
+// field names with prefix e.g. when fund account is nested.
+func (v *validation) fundAccount(prefix string, accountType *FundAccountType, bankAccount *FundAccountBankAccountParams, vpa *FundAccountVPAParams, card *FundAccountCardParams) {
+	t := FundAccountType("")
+	if accountType != nil {
+		t = *accountType
+	}
+	switch t {
+	case FundAccountTypeBankAccount:
+		if bankAccount == nil {
+			bankAccount = &FundAccountBankAccountParams{}
+		}
+		v.required(prefix+"bank_account.name", bankAccount.Name)
+		v.required(prefix+"bank_account.ifsc", bankAccount.IFSC)
+		v.format(prefix+"bank_account.ifsc", bankAccount.IFSC, ifscRegex)
+		v.required(prefix+"bank_account.account_number", bankAccount.AccountNumber)
+		v.secretFormat(prefix+"bank_account.account_number", bankAccount.AccountNumber, bankAccountNumberRegex)
+	case FundAccountTypeVPA:
+		if vpa == nil {
+			vpa = &FundAccountVPAParams{}
+		}
+		v.required(prefix+"vpa.address", vpa.Address)
+		v.secretFormat(prefix+"vpa.address", vpa.Address, vpaRegex)
+	case FundAccountTypeCard:
+		if card == nil {
+			card = &FundAccountCardParams{}
+		}
+		v.required(prefix+"card.name", card.Name)
+		v.required(prefix+"card.number", card.Number)
+		v.secretFormat(prefix+"card.number", card.Number, cardNumberRegex)
+	case "":
+		v.add(prefix+"account_type", "%saccount_type is required", prefix)
+	default:
+		v.add(prefix+"account_type", "%saccount_type %q is not supported", prefix, t)
+	}
+}
+
+func (v *validation) notes(field string, notes Notes) {
+	if len(notes) > maxNotesCount {
+		v.add(field, "%s must have at most %d keys", field, maxNotesCount)
+	}
+	keys := make([]string, 0, len(notes))
+	for key := range notes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if utf8.RuneCountInString(notes[key]) > maxNotesValueLength {
+			v.add(field+"."+key, "%s.%s must be at most %d characters", field, key, maxNotesValueLength)
+		}
+	}
+}
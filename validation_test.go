@@ -0,0 +1,244 @@
+package razorpay
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	faker "github.com/bxcodec/faker/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderParams_Validate(t *testing.T) {
+	// Case: Positive.
+	params := &OrderParams{Amount: Int64(123), Currency: CurrencyINR.Ptr()}
+	assert.Nil(t, params.Validate())
+
+	// Case: Missing and invalid fields.
+	params = &OrderParams{
+		Amount:  Int64(99),
+		Receipt: String(strings.Repeat("r", 41)),
+		Notes:   Notes{"key": strings.Repeat("v", 257)},
+	}
+	err := params.Validate()
+	var validationErrs ValidationErrors
+	assert.True(t, errors.As(err, &validationErrs))
+	fields := []string{}
+	for _, e := range validationErrs {
+		fields = append(fields, e.Field)
+	}
+	assert.Equal(t, []string{"amount", "currency", "receipt", "notes.key"}, fields)
+
+	// Case: First payment min amount without partial payment.
+	params = &OrderParams{Amount: Int64(1000), Currency: CurrencyINR.Ptr(), FirstPaymentMinAmount: Int64(500)}
+	assert.Equal(t, "field: first_payment_min_amount, description: first_payment_min_amount is allowed only when partial_payment is true", params.Validate().Error())
+	params.PartialPayment = Bool(true)
+	assert.Nil(t, params.Validate())
+
+	// Case: Discount without offers.
+	params = &OrderParams{Amount: Int64(100), Currency: CurrencyINR.Ptr(), Discount: Bool(true)}
+	assert.Equal(t, "field: discount, description: discount is allowed only with offers", params.Validate().Error())
+	params.Offers = []string{"offer_JTUADI4ZWBGWur"}
+	assert.Nil(t, params.Validate())
+
+	// Case: Emandate registration order with zero amount.
+	params = &OrderParams{
+		Amount:     Int64(0),
+		Currency:   CurrencyINR.Ptr(),
+		Method:     PaymentMethodEmandate.Ptr(),
+		CustomerID: String("cust_1Aa00000000001"),
+		Token:      &OrderTokenParams{MaxAmount: Int64(900000), AuthType: TokenAuthTypeNetbanking.Ptr()},
+	}
+	assert.Nil(t, params.Validate())
+
+	// Case: Registration order missing customer and with invalid token.
+	params = &OrderParams{
+		Amount:   Int64(100),
+		Currency: CurrencyINR.Ptr(),
+		Token:    &OrderTokenParams{MaxAmount: Int64(1), ExpireAt: Int64(time.Now().Add(-time.Hour).Unix()), AuthType: TokenAuthType("otp").Ptr()},
+	}
+	err = params.Validate()
+	assert.True(t, errors.As(err, &validationErrs))
+	fields = []string{}
+	for _, e := range validationErrs {
+		fields = append(fields, e.Field)
+	}
+	assert.Equal(t, []string{"customer_id", "method", "token.max_amount", "token.expire_at", "token.auth_type"}, fields)
+}
+
+func TestPaymentCreateParams_Validate(t *testing.T) {
+	base := func(method PaymentMethod) *PaymentCreateParams {
+		return &PaymentCreateParams{
+			Amount:    Int64(100),
+			Currency:  CurrencyINR.Ptr(),
+			Email:     String("gaurav.kumar@example.com"),
+			Contact:   String("9123456789"),
+			Method:    method.Ptr(),
+			IP:        String("127.0.0.1"),
+			UserAgent: String("Mozilla/5.0"),
+		}
+	}
+
+	// Case: Card.
+	params := base(PaymentMethodCard)
+	params.Card = &PaymentCardParams{Number: String("4111111111111111"), ExpiryMonth: String("12"), ExpiryYear: String("30"), CVV: String("123")}
+	assert.Nil(t, params.Validate())
+	params.Card.ExpiryMonth = String("13")
+	assert.Equal(t, "field: card.expiry_month, description: card.expiry_month is invalid", params.Validate().Error())
+	// Card number and cvv are not put in error.
+	params.Card = &PaymentCardParams{Number: String("41111111111111x"), ExpiryMonth: String("12"), ExpiryYear: String("30"), CVV: String("12x")}
+	err := params.Validate()
+	assert.Equal(t, "field: card.number, description: card.number is invalid; field: card.cvv, description: card.cvv is invalid", err.Error())
+	assert.NotContains(t, err.Error(), "41111111111111x")
+	assert.NotContains(t, err.Error(), "12x")
+
+	// Case: UPI intent does not need vpa, collect does.
+	params = base(PaymentMethodUPI)
+	params.UPI = &PaymentUPIParams{Flow: PaymentUPIFlowIntent.Ptr()}
+	assert.Nil(t, params.Validate())
+	params.UPI.Flow = PaymentUPIFlowCollect.Ptr()
+	assert.Equal(t, "field: upi.vpa, description: upi.vpa is required", params.Validate().Error())
+	params.UPI.VPA = String("gaurav.kumar@exampleupi")
+	assert.Nil(t, params.Validate())
+
+	// Case: Netbanking and missing method.
+	params = base(PaymentMethodNetbanking)
+	assert.Equal(t, "field: bank, description: bank is required", params.Validate().Error())
+	params.Method = nil
+	assert.Equal(t, "field: method, description: method is required", params.Validate().Error())
+}
+
+func TestPaymentRecurringParams_Validate(t *testing.T) {
+	params := &PaymentRecurringParams{
+		Amount:     Int64(100),
+		Currency:   CurrencyINR.Ptr(),
+		OrderID:    String("order_1Aa00000000001"),
+		CustomerID: String("cust_1Aa00000000001"),
+		Token:      String("token_1Aa00000000001"),
+	}
+	assert.Nil(t, params.Validate())
+
+	err := (&PaymentRecurringParams{Amount: Int64(100), Currency: CurrencyINR.Ptr()}).Validate()
+	assert.Equal(t, "field: order_id, description: order_id is required; field: customer_id, description: customer_id is required; field: token, description: token is required", err.Error())
+}
+
+func TestCustomerParams_Validate(t *testing.T) {
+	params := &CustomerParams{
+		Contact: String(faker.E164PhoneNumber()),
+		Email:   String(strings.ToLower(faker.Email())),
+	}
+	assert.Nil(t, params.Validate())
+
+	params.Email = String("not-an-email")
+	err := params.Validate()
+	assert.NotNil(t, err)
+	assert.Equal(t, "field: email, description: email is invalid", err.Error())
+	params.Email, params.Contact = nil, String("98765x3210")
+	assert.Equal(t, "field: contact, description: contact is invalid", params.Validate().Error())
+}
+
+func TestFundAccountParams_Validate(t *testing.T) {
+	params := &FundAccountParams{
+		ContactID:   String("cont_1Aa00000000001"),
+		AccountType: FundAccountTypeBankAccount.Ptr(),
+		BankAccount: &FundAccountBankAccountParams{
+			Name:          String("Gaurav Kumar"),
+			IFSC:          String("HDFC0000053"),
+			AccountNumber: String("765432123456789"),
+		},
+	}
+	assert.Nil(t, params.Validate())
+
+	params.BankAccount.IFSC = String("HDFC000053")
+	assert.Equal(t, "field: bank_account.ifsc, description: bank_account.ifsc \"HDFC000053\" is invalid", params.Validate().Error())
+
+	// Account and card numbers are not put in error.
+	params.BankAccount.IFSC = String("HDFC0000053")
+	params.BankAccount.AccountNumber = String("7654-3212-3456")
+	err := params.Validate()
+	assert.Equal(t, "field: bank_account.account_number, description: bank_account.account_number is invalid", err.Error())
+	assert.NotContains(t, err.Error(), "7654-3212-3456")
+	params = &FundAccountParams{
+		ContactID:   String("cont_1Aa00000000001"),
+		AccountType: FundAccountTypeCard.Ptr(),
+		Card:        &FundAccountCardParams{Name: String("Gaurav Kumar"), Number: String("41111111111111x")},
+	}
+	assert.NotContains(t, params.Validate().Error(), "41111111111111x")
+
+	params = &FundAccountParams{ContactID: String("cont_1Aa00000000001"), AccountType: FundAccountTypeVPA.Ptr()}
+	assert.Equal(t, "field: vpa.address, description: vpa.address is required", params.Validate().Error())
+	params.VPA = &FundAccountVPAParams{Address: String("gaurav.kumar@exampleupi")}
+	assert.Nil(t, params.Validate())
+
+	assert.Equal(t, "field: contact_id, description: contact_id is required; field: account_type, description: account_type is required", (&FundAccountParams{}).Validate().Error())
+}
+
+func TestFundAccountValidationParams_Validate(t *testing.T) {
+	params := &FundAccountValidationParams{
+		AccountNumber: String("2323230041626905"),
+		FundAccount:   &FundAccountValidationFundParams{ID: String("fa_1Aa00000000001")},
+	}
+	assert.Nil(t, params.Validate())
+
+	err := (&FundAccountValidationParams{}).Validate()
+	assert.Equal(t, "field: account_number, description: account_number is required; field: fund_account.id, description: fund_account.id is required", err.Error())
+}
+
+func TestPayoutParams_Validate(t *testing.T) {
+	params := &PayoutParams{
+		AccountNumber: String("2323230041626905"),
+		FundAccountID: String("fa_1Aa00000000001"),
+		Amount:        Int64(100),
+		Currency:      CurrencyINR.Ptr(),
+		Mode:          PayoutModeIMPS.Ptr(),
+		Purpose:       PayoutPurposeVendorBill.Ptr(),
+		Narration:     String("Invoice 12"),
+	}
+	assert.Nil(t, params.Validate())
+
+	params.Narration = String("Invoice #12")
+	assert.Equal(t, "field: narration, description: narration \"Invoice #12\" is invalid", params.Validate().Error())
+
+	params.Narration = nil
+	params.FundAccountID = nil
+	params.FundAccount = &PayoutFundAccountParams{
+		AccountType: FundAccountTypeVPA.Ptr(),
+		VPA:         &FundAccountVPAParams{Address: String("gaurav.kumar@exampleupi")},
+		Contact:     &ContactParams{Email: String("not-an-email")},
+	}
+	assert.Equal(t, "field: mode, description: mode \"IMPS\" is not supported for account_type \"vpa\"; field: fund_account.contact.name, description: fund_account.contact.name is required; field: fund_account.contact.email, description: fund_account.contact.email is invalid", params.Validate().Error())
+
+	params.Mode = PayoutModeUPI.Ptr()
+	params.FundAccount.Contact = &ContactParams{Name: String("Gaurav Kumar")}
+	assert.Nil(t, params.Validate())
+
+	// Case: Card payout over IMPS.
+	params.Mode = PayoutModeIMPS.Ptr()
+	params.FundAccount.AccountType = FundAccountTypeCard.Ptr()
+	params.FundAccount.Card = &FundAccountCardParams{Name: String("Gaurav Kumar"), Number: String("4111111111111111")}
+	assert.Nil(t, params.Validate())
+}
+
+func TestPaymentLinkParams_Validate(t *testing.T) {
+	params := &PaymentLinkParams{
+		Amount:                Int64(1000),
+		FirstMinPartialAmount: Int64(500),
+		ExpireBy:              Int64(time.Now().Add(-time.Minute).Unix()),
+		Customer:              &CustomerParams{Contact: String("12")},
+	}
+	err := params.Validate()
+	var validationErrs ValidationErrors
+	assert.True(t, errors.As(err, &validationErrs))
+	assert.Len(t, validationErrs, 3)
+	assert.Equal(t, "first_min_partial_amount", validationErrs[0].Field)
+	assert.Equal(t, "expire_by", validationErrs[1].Field)
+	assert.Equal(t, "customer.contact", validationErrs[2].Field)
+}
+
+func TestClient_ValidateParams(t *testing.T) {
+	client := NewClient("KEY", "SECRET", nil)
+	assert.NotNil(t, client.ValidateParams(&PaymentCaptureParams{}))
+	assert.NotNil(t, client.ValidateParams((*PaymentCaptureParams)(nil)))
+	assert.Nil(t, client.WithParamsValidation(false).ValidateParams(&PaymentCaptureParams{}))
+}
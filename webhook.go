@@ -0,0 +1,67 @@
+package razorpay
+
+// WebhookEvent is payload of webhook request. Only entities relevant to the
+// event are set in payload.
+// Ref: https://razorpay.com/docs/webhooks/payloads
+type WebhookEvent struct {
+	Entity    string         `json:"entity"`
+	AccountID string         `json:"account_id"`
+	Event     string         `json:"event"`
+	Contains  []string       `json:"contains"`
+	Payload   WebhookPayload `json:"payload"`
+	CreatedAt int64          `json:"created_at"`
+
+	// Warnings are values in payload which did not match type of the field.
+	Warnings []*DecodeWarning `json:"-"`
+}
+
+// WebhookPayload is entities of webhook event.
+type WebhookPayload struct {
+	Payment *WebhookPaymentEntity `json:"payment"`
+	Order   *WebhookOrderEntity   `json:"order"`
+	Refund  *WebhookRefundEntity  `json:"refund"`
+}
+
+// WebhookPaymentEntity wraps payment in webhook payload.
+type WebhookPaymentEntity struct {
+	Entity *Payment `json:"entity"`
+}
+
+// WebhookOrderEntity wraps order in webhook payload.
+type WebhookOrderEntity struct {
+	Entity *Order `json:"entity"`
+}
+
+// WebhookRefundEntity wraps refund in webhook payload.
+type WebhookRefundEntity struct {
+	Entity *Refund `json:"entity"`
+}
+
+// ParseWebhookEvent unmarshals webhook request body, tolerating inconsistent
+// shapes same as responses. Request should be validated first using
+// IsValidWebhookRequest.
+func ParseWebhookEvent(body []byte) (*WebhookEvent, error) {
+	event := &WebhookEvent{}
+	warnings, err := UnmarshalTolerant(body, event)
+	if err != nil {
+		return nil, err
+	}
+	event.Warnings = warnings
+	return event, nil
+}
+
+// Refund returns refund of event, if any.
+func (e *WebhookEvent) Refund() (*Refund, bool) {
+	if e.Payload.Refund == nil || e.Payload.Refund.Entity == nil {
+		return nil, false
+	}
+	return e.Payload.Refund.Entity, true
+}
+
+// Payment returns payment of event, if any.
+func (e *WebhookEvent) Payment() (*Payment, bool) {
+	if e.Payload.Payment == nil || e.Payload.Payment.Entity == nil {
+		return nil, false
+	}
+	return e.Payload.Payment.Entity, true
+}